@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/manifoldco/promptui"
+)
+
+type level int
+
+const (
+	debug level = iota
+	info
+	warn
+	fatal
+)
+
+func (l level) Values() []interface{} {
+	return []interface{}{debug, info, warn, fatal}
+}
+
+func (l level) String() string {
+	return [...]string{"debug", "info", "warn", "fatal"}[l]
+}
+
+type server struct {
+	Name     string   `promptui:"label=Server name,validate=required"`
+	Port     int      `promptui:"validate=port"`
+	LogLevel level    `promptui:"label=Log level"`
+	Features []string `promptui:"options=metrics|tracing|profiling"`
+	TLS      bool     `promptui:"label=Enable TLS"`
+}
+
+func main() {
+	s := server{Port: 8080, LogLevel: info}
+
+	err := promptui.Ask(&s)
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("%+v\n", s)
+}
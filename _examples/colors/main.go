@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/manifoldco/promptui"
+)
+
+type swatch struct {
+	Name string
+	Hex  string
+}
+
+func main() {
+	swatches := []swatch{
+		{Name: "Tangerine", Hex: "#ff8800"},
+		{Name: "Ocean", Hex: "#0077be"},
+		{Name: "Forest", Hex: "#228b22"},
+		{Name: "Lavender", Hex: "#b57edc"},
+		{Name: "Charcoal", Hex: "#36454f"},
+	}
+
+	templates := &promptui.SelectTemplates{
+		Label:    "{{ . }}?",
+		Active:   `{{ "▸" | brightWhite }} {{ "  " | bg .Hex }} {{ .Name | fg .Hex | bold }}`,
+		Inactive: `  {{ "  " | bg .Hex }} {{ .Name | fg .Hex }}`,
+		Selected: `{{ "✔" | brightGreen }} {{ .Name | fg .Hex }}`,
+		Details: `
+{{ "Hex:" | faint }}	{{ .Hex }}
+{{ "256 colors:" | faint }}	{{ "sample" | fg 208 }}`,
+	}
+
+	prompt := promptui.Select{
+		Label:     "Accent color",
+		Items:     swatches,
+		Templates: templates,
+	}
+
+	i, _, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("You chose %s\n", swatches[i].Name)
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/manifoldco/promptui"
+)
+
+func main() {
+	validate := func(input string) error {
+		if strings.TrimSpace(input) == "" {
+			return errors.New("Config must not be empty")
+		}
+		return nil
+	}
+
+	prompt := promptui.Editor{
+		Label:     "Config",
+		Default:   "name: example\nreplicas: 1\n",
+		Validate:  validate,
+		Extension: ".yaml",
+	}
+
+	result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("You wrote:\n%s\n", result)
+}
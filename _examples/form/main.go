@@ -0,0 +1,61 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/manifoldco/promptui"
+)
+
+type user struct {
+	Name     string
+	Age      int
+	Admin    bool
+	Team     string
+	Projects []string
+}
+
+func main() {
+	validate := func(input string) error {
+		_, err := strconv.Atoi(input)
+		if err != nil {
+			return errors.New("Invalid number")
+		}
+		return nil
+	}
+
+	form := promptui.Form{
+		Questions: []promptui.Question{
+			{Name: "Name", Prompt: &promptui.Prompt{Label: "Name"}},
+			{Name: "Age", Prompt: &promptui.Prompt{Label: "Age", Validate: validate}},
+			{Name: "Admin", Prompt: &promptui.Prompt{Label: "Admin", IsConfirm: true}},
+			{
+				Name:   "Team",
+				Prompt: &promptui.Select{Label: "Team", Items: []string{"Platform", "Payments", "Growth"}},
+				Skip: func(answers map[string]interface{}) bool {
+					return answers["Admin"] == true
+				},
+			},
+			{
+				Name: "Projects",
+				Prompt: &promptui.MultiSelect{
+					Label: "Projects",
+					Items: []string{"api", "dashboard", "mobile", "website"},
+					Min:   1,
+				},
+			},
+		},
+		Summary: `{{ "Created" | green }} {{ .Name | bold }}, {{ .Age }}{{ if .Admin }} (admin){{ end }}`,
+	}
+
+	var u user
+	err := form.Run(&u)
+
+	if err != nil {
+		fmt.Printf("Form failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("%+v\n", u)
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/manifoldco/promptui"
+	"github.com/manifoldco/promptui/list"
+)
+
+func main() {
+	branches := []string{
+		"main",
+		"feature/fuzzy-search",
+		"feature/multi-select",
+		"fix/select-scroll",
+		"release/1.0",
+		"release/1.1",
+	}
+
+	prompt := promptui.Select{
+		Label: "Checkout branch",
+		Items: branches,
+		Ranker: list.Fuzzy(func(index int) string {
+			return branches[index]
+		}),
+		Templates: &promptui.SelectTemplates{
+			Active:   "▸ {{ . | highlight }}",
+			Inactive: "  {{ . | highlight }}",
+		},
+		StartInSearchMode: true,
+	}
+
+	_, result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("You choose %q\n", result)
+}
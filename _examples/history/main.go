@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/manifoldco/promptui"
+)
+
+func main() {
+	history := &promptui.History{
+		File: filepath.Join(os.TempDir(), "promptui_history.json"),
+	}
+
+	host := promptui.Prompt{
+		Label:   "Host",
+		ID:      "host",
+		History: history,
+	}
+
+	hostname, err := host.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	user := promptui.Prompt{
+		Label:   "User",
+		ID:      "user",
+		History: history,
+	}
+
+	username, err := user.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("Connecting to %s@%s\n", username, hostname)
+}
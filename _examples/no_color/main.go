@@ -0,0 +1,32 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/manifoldco/promptui"
+)
+
+func main() {
+	noColor := flag.Bool("no-color", false, "disable colors, like setting NO_COLOR")
+	flag.Parse()
+
+	if *noColor {
+		promptui.DefaultColorProfile = promptui.NoColor
+	}
+
+	prompt := promptui.Select{
+		Label: "Select Day",
+		Items: []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday",
+			"Saturday", "Sunday"},
+	}
+
+	_, result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("You choose %q\n", result)
+}
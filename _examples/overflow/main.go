@@ -0,0 +1,37 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/manifoldco/promptui"
+)
+
+func main() {
+	truncate := flag.Bool("truncate", false, "truncate long items instead of wrapping them")
+	flag.Parse()
+
+	var items []string
+	for i := 1; i <= 10; i++ {
+		items = append(items, fmt.Sprintf("Item %d %s", i, strings.Repeat("is quite long ", i*2)))
+	}
+
+	prompt := promptui.Select{
+		Label: "Select an item",
+		Items: items,
+	}
+
+	if *truncate {
+		prompt.Overflow = promptui.OverflowTruncate
+	}
+
+	i, _, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("You choose item %d\n", i+1)
+}
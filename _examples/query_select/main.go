@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/manifoldco/promptui"
+)
+
+func main() {
+	// Searches the files of the current directory tree whose name contains the term, streaming them as they are
+	// found and stopping as soon as the term changes.
+	query := func(ctx context.Context, term string) (interface{}, error) {
+		if term == "" {
+			return nil, errors.New("type to search files")
+		}
+
+		files := make(chan string)
+
+		go func() {
+			defer close(files)
+			filepath.Walk(".", func(path string, info os.FileInfo, err error) error {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				if err == nil && !info.IsDir() && strings.Contains(info.Name(), term) {
+					select {
+					case files <- path:
+					case <-ctx.Done():
+					}
+				}
+				return nil
+			})
+		}()
+
+		return files, nil
+	}
+
+	prompt := promptui.Select{
+		Label: "File",
+		Query: query,
+		Size:  10,
+	}
+
+	_, result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("You choose %q\n", result)
+}
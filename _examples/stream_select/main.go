@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/manifoldco/promptui"
+)
+
+func main() {
+	regions := make(chan string)
+
+	// Simulates a slow API call listing the regions one page at a time.
+	go func() {
+		defer close(regions)
+		pages := [][]string{
+			{"us-east-1", "us-east-2", "us-west-1", "us-west-2"},
+			{"eu-west-1", "eu-west-2", "eu-central-1"},
+			{"ap-south-1", "ap-southeast-1", "ap-northeast-1"},
+		}
+		for _, page := range pages {
+			time.Sleep(time.Second)
+			for _, region := range page {
+				regions <- region
+			}
+		}
+	}()
+
+	prompt := promptui.Select{
+		Label: "Region",
+		Items: regions,
+	}
+
+	_, result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("You choose %q\n", result)
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/manifoldco/promptui"
+)
+
+var hosts = []string{
+	"api.example.com",
+	"api-staging.example.com",
+	"db1.example.com",
+	"db2.example.com",
+	"web.example.com",
+	"worker.example.com",
+}
+
+func main() {
+	prompt := promptui.Prompt{
+		Label: "Host",
+		Suggest: func(input string) []string {
+			var result []string
+			for _, h := range hosts {
+				if input != "" && strings.Contains(h, input) {
+					result = append(result, h)
+				}
+			}
+			return result
+		},
+	}
+
+	result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("Connecting to %s\n", result)
+}
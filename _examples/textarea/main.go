@@ -0,0 +1,33 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/manifoldco/promptui"
+)
+
+func main() {
+	validate := func(input string) error {
+		if strings.TrimSpace(input) == "" {
+			return errors.New("Description must not be empty")
+		}
+		return nil
+	}
+
+	prompt := promptui.TextArea{
+		Label:     "Description",
+		Validate:  validate,
+		MaxLength: 500,
+	}
+
+	result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("You wrote:\n%s\n", result)
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/manifoldco/promptui"
+)
+
+func main() {
+	if os.Getenv("ASCII") != "" {
+		promptui.DefaultTheme = promptui.ASCIITheme
+	}
+
+	brand := &promptui.Theme{
+		Icons: promptui.Icons{
+			Initial: "»",
+			Good:    "●",
+		},
+		IconStyles: promptui.IconStyles{
+			Initial: promptui.Styler(promptui.FGMagenta),
+			Good:    promptui.Styler(promptui.FGMagenta),
+		},
+		Styles: promptui.Styles{
+			Label:   promptui.Styler(promptui.FGMagenta, promptui.FGBold),
+			Success: promptui.Styler(promptui.FGMagenta),
+		},
+		Prompt: promptui.PromptTemplates{
+			Success: `{{ . | muted }} {{ "›" | muted }} `,
+		},
+	}
+
+	prompt := promptui.Prompt{
+		Label: "Project name",
+		Theme: brand,
+	}
+
+	name, err := prompt.Run()
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	sel := promptui.Select{
+		Label: "Template",
+		Items: []string{"api", "cli", "web"},
+		Theme: brand,
+	}
+
+	_, template, err := sel.Run()
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("Creating %s from the %s template\n", name, template)
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/manifoldco/promptui"
+)
+
+func main() {
+	min, max := 0.0, 1.0
+
+	ratio := promptui.FloatPrompt{
+		Label: "Sampling ratio",
+		Min:   &min,
+		Max:   &max,
+		Step:  0.05,
+	}
+
+	r, err := ratio.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	def := 30 * time.Second
+
+	interval := promptui.DurationPrompt{
+		Label:   "Flush interval",
+		Default: &def,
+		Step:    5 * time.Second,
+	}
+
+	d, err := interval.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("Sampling %.0f%% of the traces, flushed every %v\n", r*100, d)
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/manifoldco/promptui"
+)
+
+func main() {
+	prompt := promptui.Prompt{
+		Label: "Listen address",
+		Validate: func(input string) error {
+			_, _, err := net.SplitHostPort(input)
+			if err != nil {
+				return errors.New("expected host:port")
+			}
+			return nil
+		},
+		ValidateAsync: func(ctx context.Context, input string) error {
+			// simulate a slow check, canceled as soon as the input changes
+			select {
+			case <-time.After(time.Second):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+
+			l, err := net.Listen("tcp", input)
+			if err != nil {
+				return fmt.Errorf("%s is not available", input)
+			}
+			return l.Close()
+		},
+		ValidateDelay: 200 * time.Millisecond,
+	}
+
+	result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("Listening on %s\n", result)
+}
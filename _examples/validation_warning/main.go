@@ -0,0 +1,38 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/manifoldco/promptui"
+)
+
+func main() {
+	validate := func(input string) error {
+		length := utf8.RuneCountInString(input)
+		if length < 6 {
+			return errors.New("Password must have at least 6 characters")
+		}
+		if length < 12 {
+			return promptui.Warnf("Passwords shorter than 12 characters are easy to guess")
+		}
+		return nil
+	}
+
+	prompt := promptui.Prompt{
+		Label:           "Password",
+		Validate:        validate,
+		Mask:            '*',
+		ConfirmWarnings: true,
+	}
+
+	result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("Your password is %d characters long\n", utf8.RuneCountInString(result))
+}
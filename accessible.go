@@ -0,0 +1,193 @@
+package promptui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/chzyer/readline"
+)
+
+// Accessible makes all prompts and selects run in accessible mode, as their Accessible field does. It defaults to
+// whether the PROMPTUI_ACCESSIBLE environment variable is set.
+//
+// In accessible mode, prompts and selects do not redraw their output in place, which screen readers read as
+// noise. They only append plain text lines instead: selects print a numbered list of their items and ask for a
+// number, and invalid answers are reported on a new line before asking again. The cursor is never hidden and no
+// icons are displayed.
+var Accessible = os.Getenv("PROMPTUI_ACCESSIBLE") != ""
+
+// echoesInput reports whether the answers read from in are echoed by the terminal, the line feed included.
+func echoesInput(in io.Reader) bool {
+	f, ok := in.(fileDescriptor)
+	return ok && readline.IsTerminal(int(f.Fd()))
+}
+
+// readAccessible reads an answer in accessible mode, without echoing it when masked is set and in is a terminal.
+func readAccessible(in io.Reader, out io.Writer, masked bool) (string, error) {
+	if masked && echoesInput(in) {
+		answer, err := readline.ReadPassword(int(in.(fileDescriptor).Fd()))
+		fmt.Fprintln(out)
+		return string(answer), err
+	}
+
+	answer, err := readLine(in)
+	if !echoesInput(in) {
+		fmt.Fprintln(out)
+	}
+	return answer, err
+}
+
+func (p *Prompt) runAccessible(ctx context.Context) (string, error) {
+	in, out := lineStreams(p.Stdin, p.Stdout)
+
+	label := fmt.Sprintf("%v: ", p.Label)
+	switch {
+	case p.IsConfirm:
+		confirm := "y/N"
+		if strings.ToLower(p.Default) == "y" {
+			confirm = "Y/n"
+		}
+		label = fmt.Sprintf("%v? [%s] ", p.Label, confirm)
+	case p.Default != "" && p.Mask == 0:
+		label = fmt.Sprintf("%v [%s]: ", p.Label, p.Default)
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+
+		fmt.Fprint(out, label)
+
+		answer, err := readAccessible(in, out, p.Mask != 0)
+		if err != nil {
+			return "", err
+		}
+
+		value, err := p.answer(ctx, answer)
+		if err == nil || err == ErrAbort {
+			return value, err
+		}
+
+		fmt.Fprintf(out, "Error: %v\n", err)
+	}
+}
+
+// runAccessible reads the lines of the text until an empty line, since the submit key cannot be read without
+// driving the terminal.
+func (ta *TextArea) runAccessible(ctx context.Context) (string, error) {
+	in, out := lineStreams(ta.Stdin, ta.Stdout)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(out, "%v (end with an empty line):\n", ta.Label)
+
+		var lines []string
+		for {
+			line, err := readAccessible(in, out, false)
+			if err == ErrNoInput && len(lines) > 0 {
+				break
+			}
+			if err != nil {
+				return "", err
+			}
+			if line == "" {
+				break
+			}
+			lines = append(lines, line)
+		}
+
+		value, err := ta.answer(strings.Join(lines, "\n"))
+		if err == nil {
+			return value, nil
+		}
+
+		fmt.Fprintf(out, "Error: %v\n", err)
+	}
+}
+
+func (e *Editor) runAccessible(ctx context.Context) (string, error) {
+	in, out := lineStreams(e.Stdin, e.Stdout)
+
+	text := e.Default
+	for {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(out, "%v: press enter to launch the editor ", e.Label)
+
+		_, err := readAccessible(in, out, false)
+		if err != nil {
+			return "", err
+		}
+
+		text, err = e.edit(text)
+		if err != nil {
+			return "", err
+		}
+
+		if e.Validate == nil {
+			return text, nil
+		}
+
+		err = e.Validate(text)
+		if err == nil {
+			return text, nil
+		}
+
+		fmt.Fprintf(out, "Error: %v\n", err)
+	}
+}
+
+func (s *Select) runAccessible(ctx context.Context, cursorPos int) (int, string, error) {
+	in, out := lineStreams(s.Stdin, s.Stdout)
+
+	if s.Query != nil {
+		if err := s.queryLine(ctx); err != nil {
+			return 0, "", err
+		}
+	}
+
+	items := s.itemValues()
+
+	fmt.Fprintf(out, "%v\n", s.Label)
+	for i := 0; i < items.Len(); i++ {
+		checked := ""
+		if s.multi != nil && s.multi.checked[i] {
+			checked = " (checked)"
+		}
+		fmt.Fprintf(out, "  %d) %v%s\n", i+1, items.Index(i).Interface(), checked)
+	}
+
+	choose := fmt.Sprintf("Choose 1-%d: ", items.Len())
+	if s.multi != nil {
+		choose = fmt.Sprintf("Choose any of 1-%d, separated by commas: ", items.Len())
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return 0, "", err
+		}
+
+		fmt.Fprint(out, choose)
+
+		answer, err := readAccessible(in, out, false)
+		if err != nil {
+			return 0, "", err
+		}
+
+		idx, value, err := s.answer(answer, cursorPos)
+		if err == nil {
+			return idx, value, nil
+		}
+
+		fmt.Fprintf(out, "Error: %v\n", err)
+	}
+}
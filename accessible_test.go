@@ -0,0 +1,191 @@
+package promptui
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPromptAccessible(t *testing.T) {
+	t.Run("asks again after an invalid answer", func(t *testing.T) {
+		stdin := pipeInput(t, "abc\n42\n")
+		defer stdin.Close()
+
+		out := &nopWriteCloser{}
+		p := Prompt{
+			Label: "Number",
+			Validate: func(input string) error {
+				if input != "42" {
+					return errors.New("not the answer")
+				}
+				return nil
+			},
+			Accessible: true,
+			Stdin:      stdin,
+			Stdout:     out,
+		}
+
+		result, err := p.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if result != "42" {
+			t.Errorf("expected %q, got %q", "42", result)
+		}
+
+		exp := "Number: \nError: not the answer\nNumber: \n"
+		if got := out.String(); got != exp {
+			t.Errorf("expected output %q, got %q", exp, got)
+		}
+	})
+
+	t.Run("shows the default", func(t *testing.T) {
+		stdin := pipeInput(t, "\n")
+		defer stdin.Close()
+
+		out := &nopWriteCloser{}
+		p := Prompt{Label: "Name", Default: "me", Accessible: true, Stdin: stdin, Stdout: out}
+
+		result, err := p.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if result != "me" {
+			t.Errorf("expected %q, got %q", "me", result)
+		}
+
+		if got := out.String(); got != "Name [me]: \n" {
+			t.Errorf("expected output %q, got %q", "Name [me]: \n", got)
+		}
+	})
+
+	t.Run("confirms", func(t *testing.T) {
+		stdin := pipeInput(t, "maybe\nn\n")
+		defer stdin.Close()
+
+		out := &nopWriteCloser{}
+		p := Prompt{Label: "Delete", IsConfirm: true, Accessible: true, Stdin: stdin, Stdout: out}
+
+		_, err := p.Run()
+		if err != ErrAbort {
+			t.Errorf("expected %v, got %v", ErrAbort, err)
+		}
+
+		if got := out.String(); !strings.Contains(got, "Delete? [y/N] \nError: ") {
+			t.Errorf("expected the invalid answer to be reported, got %q", got)
+		}
+	})
+
+	t.Run("returns the end of the input", func(t *testing.T) {
+		stdin := pipeInput(t, "abc\n")
+		defer stdin.Close()
+
+		p := Prompt{
+			Label:      "Number",
+			Validate:   func(string) error { return errors.New("invalid") },
+			Accessible: true,
+			Stdin:      stdin,
+			Stdout:     &nopWriteCloser{},
+		}
+
+		_, err := p.Run()
+		if err != ErrNoInput {
+			t.Errorf("expected %v, got %v", ErrNoInput, err)
+		}
+	})
+}
+
+func TestTextAreaAccessible(t *testing.T) {
+	stdin := pipeInput(t, "one\n\nfirst\nsecond\n\n")
+	defer stdin.Close()
+
+	out := &nopWriteCloser{}
+	ta := TextArea{
+		Label: "Message",
+		Validate: func(input string) error {
+			if !strings.Contains(input, "\n") {
+				return errors.New("write two lines")
+			}
+			return nil
+		},
+		Accessible: true,
+		Stdin:      stdin,
+		Stdout:     out,
+	}
+
+	result, err := ta.Run()
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if result != "first\nsecond" {
+		t.Errorf("expected %q, got %q", "first\nsecond", result)
+	}
+
+	exp := "Message (end with an empty line):\n\n\nError: write two lines\nMessage (end with an empty line):\n\n\n\n"
+	if got := out.String(); got != exp {
+		t.Errorf("expected output %q, got %q", exp, got)
+	}
+}
+
+func TestSelectAccessible(t *testing.T) {
+	t.Run("lists numbered items", func(t *testing.T) {
+		stdin := pipeInput(t, "4\n2\n")
+		defer stdin.Close()
+
+		out := &nopWriteCloser{}
+		s := Select{
+			Label:      "Number",
+			Items:      []string{"Zero", "One", "Two"},
+			Accessible: true,
+			Stdin:      stdin,
+			Stdout:     out,
+		}
+
+		idx, result, err := s.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if idx != 1 || result != "One" {
+			t.Errorf("expected item %d %q, got %d %q", 1, "One", idx, result)
+		}
+
+		exp := "Number\n  1) Zero\n  2) One\n  3) Two\n" +
+			"Choose 1-3: \nError: \"4\" does not match any item\nChoose 1-3: \n"
+		if got := out.String(); got != exp {
+			t.Errorf("expected output %q, got %q", exp, got)
+		}
+	})
+
+	t.Run("checks items of multi selects", func(t *testing.T) {
+		stdin := pipeInput(t, "1,3\n")
+		defer stdin.Close()
+
+		out := &nopWriteCloser{}
+		ms := MultiSelect{
+			Label:      "Numbers",
+			Items:      []string{"Zero", "One", "Two"},
+			Checked:    []int{1},
+			Accessible: true,
+			Stdin:      stdin,
+			Stdout:     out,
+		}
+
+		indices, _, err := ms.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if exp := []int{0, 2}; !reflect.DeepEqual(indices, exp) {
+			t.Errorf("expected %v, got %v", exp, indices)
+		}
+
+		if got := out.String(); !strings.Contains(got, "  2) One (checked)\n") {
+			t.Errorf("expected the checked item to be listed, got %q", got)
+		}
+	})
+}
@@ -0,0 +1,58 @@
+package promptui
+
+import (
+	"os"
+	"strings"
+	"unicode"
+)
+
+// AnswerProvider supplies preset answers to prompts, selects and multi selects which have an ID. When a provider
+// returns an answer for a prompt's ID, Run returns it directly instead of asking the user, which lets the same
+// code path serve both interactive and scripted runs.
+//
+// Preset answers go through the same checks as the ones typed by the user: they are validated with the prompt's
+// Validate function, answered with y or n for confirm prompts and matched against the Items of selects, either by
+// value or by position starting at 1. Multi selects accept a comma separated list of items.
+type AnswerProvider interface {
+	// Answer returns the answer for the prompt identified by id and whether there is one.
+	Answer(id string) (string, bool)
+}
+
+// AnswerMap is an AnswerProvider backed by a map of prompt IDs to answers.
+type AnswerMap map[string]string
+
+// Answer returns the answer stored under id.
+func (m AnswerMap) Answer(id string) (string, bool) {
+	answer, ok := m[id]
+	return answer, ok
+}
+
+// EnvAnswers is an AnswerProvider reading answers from environment variables. The variable name is the
+// EnvAnswers value followed by the prompt's ID in upper case, with any character other than letters and digits
+// replaced by an underscore. For example, the prompt "db-host" is answered by PROMPTUI_ANSWER_DB_HOST with the
+// default provider.
+type EnvAnswers string
+
+// Answer returns the value of the environment variable named after id, if it is set.
+func (prefix EnvAnswers) Answer(id string) (string, bool) {
+	name := strings.Map(func(r rune) rune {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return '_'
+		}
+		return unicode.ToUpper(r)
+	}, id)
+
+	return os.LookupEnv(string(prefix) + name)
+}
+
+// Answers is the AnswerProvider used to look up preset answers for prompts with an ID. It defaults to
+// environment variables prefixed with PROMPTUI_ANSWER_ and can be replaced, or set to nil to always ask the user.
+var Answers AnswerProvider = EnvAnswers("PROMPTUI_ANSWER_")
+
+// presetAnswer returns the preset answer for the given prompt ID, if any.
+func presetAnswer(id string) (string, bool) {
+	if id == "" || Answers == nil {
+		return "", false
+	}
+	return Answers.Answer(id)
+}
@@ -0,0 +1,97 @@
+package promptui
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestEnvAnswers(t *testing.T) {
+	os.Setenv("TEST_ANSWER_DB_HOST_2", "localhost")
+	defer os.Unsetenv("TEST_ANSWER_DB_HOST_2")
+
+	answers := EnvAnswers("TEST_ANSWER_")
+
+	answer, ok := answers.Answer("db-host.2")
+	if !ok || answer != "localhost" {
+		t.Errorf("expected %q, got %q (%t)", "localhost", answer, ok)
+	}
+
+	_, ok = answers.Answer("db-port")
+	if ok {
+		t.Errorf("expected no answer for unset variable")
+	}
+}
+
+func TestPresetAnswers(t *testing.T) {
+	defer func(a AnswerProvider) { Answers = a }(Answers)
+
+	Answers = AnswerMap{
+		"name":    "Pepper",
+		"number":  "abc",
+		"confirm": "n",
+		"day":     "Tuesday",
+		"days":    "1,Tuesday",
+		"editor":  "Nano",
+	}
+
+	t.Run("prompt", func(t *testing.T) {
+		p := Prompt{ID: "name", Label: "Name"}
+
+		result, err := p.Run()
+		if err != nil || result != "Pepper" {
+			t.Errorf("expected %q, got %q (%v)", "Pepper", result, err)
+		}
+	})
+
+	t.Run("prompt with invalid answer", func(t *testing.T) {
+		invalid := errors.New("invalid number")
+		p := Prompt{
+			ID:       "number",
+			Label:    "Number",
+			Validate: func(string) error { return invalid },
+		}
+
+		_, err := p.Run()
+		if err != invalid {
+			t.Errorf("expected %v, got %v", invalid, err)
+		}
+	})
+
+	t.Run("confirm", func(t *testing.T) {
+		p := Prompt{ID: "confirm", Label: "Sure", IsConfirm: true}
+
+		_, err := p.Run()
+		if err != ErrAbort {
+			t.Errorf("expected %v, got %v", ErrAbort, err)
+		}
+	})
+
+	t.Run("select", func(t *testing.T) {
+		s := Select{ID: "day", Label: "Day", Items: []string{"Monday", "Tuesday"}}
+
+		idx, result, err := s.Run()
+		if err != nil || idx != 1 || result != "Tuesday" {
+			t.Errorf("expected 1 %q, got %d %q (%v)", "Tuesday", idx, result, err)
+		}
+	})
+
+	t.Run("multi select", func(t *testing.T) {
+		ms := MultiSelect{ID: "days", Label: "Days", Items: []string{"Monday", "Tuesday", "Wednesday"}}
+
+		indices, _, err := ms.Run()
+		if err != nil || !reflect.DeepEqual([]int{0, 1}, indices) {
+			t.Errorf("expected [0 1], got %v (%v)", indices, err)
+		}
+	})
+
+	t.Run("select with add", func(t *testing.T) {
+		sa := SelectWithAdd{ID: "editor", Label: "Editor", Items: []string{"Vim", "Emacs"}, AddLabel: "Other"}
+
+		idx, result, err := sa.Run()
+		if err != nil || idx != SelectedAdd || result != "Nano" {
+			t.Errorf("expected %d %q, got %d %q (%v)", SelectedAdd, "Nano", idx, result, err)
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package promptui
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// defaultValidateDelay is the time a prompt waits after the last key press before running its ValidateAsync.
+const defaultValidateDelay = 300 * time.Millisecond
+
+// errValidating is the validation error of a prompt while the asynchronous validation of its input is running.
+var errValidating = errors.New("validation in progress")
+
+// AsyncValidateFunc validates the input of a prompt like a ValidateFunc, but runs in the background so that slow
+// checks do not block the user. The context is canceled as soon as the input changes or the prompt ends.
+type AsyncValidateFunc func(ctx context.Context, input string) error
+
+// checker runs the ValidateAsync function of a prompt each time its input changes. Like the querier of a select,
+// its state is guarded by the prompt's mutex.
+type checker struct {
+	validate AsyncValidateFunc
+	delay    time.Duration
+
+	mu   *sync.Mutex
+	draw func()
+
+	// input is the input of the latest check, which is running while pending is set and otherwise ended with err.
+	input   string
+	started bool
+	pending bool
+	err     error
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// check returns the result of the validation of input. When input changed since the latest check, the running
+// check is canceled and a new one starts once the delay has passed, errValidating being returned until it ends.
+// It must be called with the mutex held.
+func (c *checker) check(ctx context.Context, input string) error {
+	if c.started && c.input == input {
+		if c.pending {
+			return errValidating
+		}
+		return c.err
+	}
+
+	if c.cancel != nil {
+		c.cancel()
+	}
+
+	ctx, c.cancel = context.WithCancel(ctx)
+
+	c.input = input
+	c.started = true
+	c.pending = true
+	c.err = nil
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		timer := time.NewTimer(c.delay)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return
+		}
+
+		err := c.validate(ctx, input)
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		// the check is stale once its context is canceled, which only happens with the mutex held.
+		if ctx.Err() != nil {
+			return
+		}
+
+		c.pending = false
+		c.err = err
+		c.draw()
+	}()
+
+	return errValidating
+}
+
+// stop cancels the running check and waits for it to return. It must be called without the mutex held.
+func (c *checker) stop() {
+	c.mu.Lock()
+	if c.cancel != nil {
+		c.cancel()
+	}
+	c.mu.Unlock()
+
+	c.wg.Wait()
+}
@@ -0,0 +1,181 @@
+package promptui
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/manifoldco/promptui/promptuitest"
+)
+
+func TestPromptValidateAsync(t *testing.T) {
+	type result struct {
+		value string
+		err   error
+	}
+
+	t.Run("submits once the latest check passes", func(t *testing.T) {
+		stdin, w := io.Pipe()
+		defer w.Close()
+
+		term := promptuitest.New()
+		gate := make(chan struct{})
+
+		p := Prompt{
+			Label: "Project",
+			ValidateAsync: func(ctx context.Context, input string) error {
+				select {
+				case <-gate:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+				if input == "taken" {
+					return errors.New("taken is not available")
+				}
+				return nil
+			},
+			ValidateDelay: time.Millisecond,
+			Stdin:         stdin,
+			Stdout:        term.Stdout(),
+		}
+
+		done := make(chan result)
+		go func() {
+			value, err := p.Run()
+			done <- result{value, err}
+		}()
+
+		w.Write([]byte("taken"))
+		waitFor(t, "the pending check", func() bool {
+			return strings.Contains(term.Screen(), "⚠ Project: taken")
+		})
+
+		w.Write([]byte(promptuitest.Enter))
+		waitFor(t, "the submission to be refused", func() bool {
+			return strings.Contains(term.Screen(), "validation in progress")
+		})
+
+		close(gate)
+		waitFor(t, "the failed check", func() bool {
+			return strings.Contains(term.Screen(), "✗ Project: taken")
+		})
+
+		w.Write([]byte(promptuitest.Enter))
+		waitFor(t, "the validation error", func() bool {
+			return strings.Contains(term.Screen(), "taken is not available")
+		})
+
+		w.Write([]byte(strings.Repeat(promptuitest.Backspace, 5) + "free"))
+		waitFor(t, "the passed check", func() bool {
+			return strings.Contains(term.Screen(), "✔ Project: free")
+		})
+
+		w.Write([]byte(promptuitest.Enter))
+
+		r := <-done
+		if r.err != nil {
+			t.Fatalf("Unexpected error %v", r.err)
+		}
+
+		if r.value != "free" {
+			t.Errorf("expected %q, got %q", "free", r.value)
+		}
+	})
+
+	t.Run("cancels stale checks", func(t *testing.T) {
+		stdin, w := io.Pipe()
+		defer w.Close()
+
+		term := promptuitest.New()
+
+		var mu sync.Mutex
+		var canceled []string
+		started := make(chan string, 10)
+
+		p := Prompt{
+			Label: "Project",
+			Validate: func(input string) error {
+				if input == "" {
+					return errors.New("required")
+				}
+				return nil
+			},
+			ValidateAsync: func(ctx context.Context, input string) error {
+				started <- input
+				if input == "ab" {
+					return nil
+				}
+
+				<-ctx.Done()
+				mu.Lock()
+				canceled = append(canceled, input)
+				mu.Unlock()
+				return ctx.Err()
+			},
+			ValidateDelay: time.Millisecond,
+			Stdin:         stdin,
+			Stdout:        term.Stdout(),
+		}
+
+		done := make(chan result)
+		go func() {
+			value, err := p.Run()
+			done <- result{value, err}
+		}()
+
+		w.Write([]byte("a"))
+		if input := <-started; input != "a" {
+			t.Fatalf("expected a check of %q, got %q", "a", input)
+		}
+
+		w.Write([]byte("b"))
+		if input := <-started; input != "ab" {
+			t.Fatalf("expected a check of %q, got %q", "ab", input)
+		}
+
+		waitFor(t, "the passed check", func() bool {
+			return strings.Contains(term.Screen(), "✔ Project: ab")
+		})
+
+		w.Write([]byte(promptuitest.Enter))
+
+		r := <-done
+		if r.err != nil {
+			t.Fatalf("Unexpected error %v", r.err)
+		}
+
+		if r.value != "ab" {
+			t.Errorf("expected %q, got %q", "ab", r.value)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if len(canceled) != 1 || canceled[0] != "a" {
+			t.Errorf("expected the check of %q to be canceled, got %q", "a", canceled)
+		}
+	})
+
+	t.Run("validates answers in line mode", func(t *testing.T) {
+		stdin := pipeInput(t, "taken\n")
+		defer stdin.Close()
+
+		p := Prompt{
+			Label: "Project",
+			ValidateAsync: func(ctx context.Context, input string) error {
+				return errors.New("taken is not available")
+			},
+			Stdin:  stdin,
+			Stdout: &nopWriteCloser{},
+		}
+
+		_, err := p.Run()
+		if err == nil || err.Error() != "taken is not available" {
+			t.Errorf("expected the async validation error, got %v", err)
+		}
+	})
+}
@@ -51,6 +51,34 @@ const (
 	BGWhite
 )
 
+// The possible bright colors of text inside the application.
+//
+// These constants are called through the use of the Styler function.
+const (
+	FGBrightBlack attribute = iota + 90
+	FGBrightRed
+	FGBrightGreen
+	FGBrightYellow
+	FGBrightBlue
+	FGBrightMagenta
+	FGBrightCyan
+	FGBrightWhite
+)
+
+// The possible bright background colors of text inside the application.
+//
+// These constants are called through the use of the Styler function.
+const (
+	BGBrightBlack attribute = iota + 100
+	BGBrightRed
+	BGBrightGreen
+	BGBrightYellow
+	BGBrightBlue
+	BGBrightMagenta
+	BGBrightCyan
+	BGBrightWhite
+)
+
 // ResetCode is the character code used to reset the terminal formatting
 var ResetCode = fmt.Sprintf("%s%dm", esc, reset)
 
@@ -64,6 +92,11 @@ const (
 //
 // The functions inside the map link the state, color and background colors strings detected in templates to a Styler
 // function that applies the given style using the corresponding constant.
+//
+// The fg and bg functions color the text or its background with any color of the 256 colors palette, given by its
+// index, or any RGB color, given as a hex string. See the Color docs for more info.
+//
+//	{{ . | fg "#ff8800" }} {{ . | bg 202 }}
 var FuncMap = template.FuncMap{
 	"black":     Styler(FGBlack),
 	"red":       Styler(FGRed),
@@ -81,6 +114,26 @@ var FuncMap = template.FuncMap{
 	"bgMagenta": Styler(BGMagenta),
 	"bgCyan":    Styler(BGCyan),
 	"bgWhite":   Styler(BGWhite),
+
+	"brightBlack":     Styler(FGBrightBlack),
+	"brightRed":       Styler(FGBrightRed),
+	"brightGreen":     Styler(FGBrightGreen),
+	"brightYellow":    Styler(FGBrightYellow),
+	"brightBlue":      Styler(FGBrightBlue),
+	"brightMagenta":   Styler(FGBrightMagenta),
+	"brightCyan":      Styler(FGBrightCyan),
+	"brightWhite":     Styler(FGBrightWhite),
+	"bgBrightBlack":   Styler(BGBrightBlack),
+	"bgBrightRed":     Styler(BGBrightRed),
+	"bgBrightGreen":   Styler(BGBrightGreen),
+	"bgBrightYellow":  Styler(BGBrightYellow),
+	"bgBrightBlue":    Styler(BGBrightBlue),
+	"bgBrightMagenta": Styler(BGBrightMagenta),
+	"bgBrightCyan":    Styler(BGBrightCyan),
+	"bgBrightWhite":   Styler(BGBrightWhite),
+	"fg":              fg,
+	"bg":              bg,
+
 	"bold":      Styler(FGBold),
 	"faint":     Styler(FGFaint),
 	"italic":    Styler(FGItalic),
@@ -100,7 +153,8 @@ func movementCode(n uint, code rune) string {
 // to apply those styles in the CLI.
 //
 // The returned styling function accepts a string that will be extended with
-// the wrapping function's styling attributes.
+// the wrapping function's styling attributes. Prompts and selects render the
+// styles within their color profile, stripping them on terminals without colors.
 func Styler(attrs ...attribute) func(interface{}) string {
 	attrstrs := make([]string, len(attrs))
 	for i, v := range attrs {
@@ -110,11 +164,16 @@ func Styler(attrs ...attribute) func(interface{}) string {
 	seq := strings.Join(attrstrs, ";")
 
 	return func(v interface{}) string {
-		end := ""
-		s, ok := v.(string)
-		if !ok || !strings.HasSuffix(s, ResetCode) {
-			end = ResetCode
-		}
-		return fmt.Sprintf("%s%sm%v%s", esc, seq, v, end)
+		return style(seq, v)
+	}
+}
+
+// style applies the SGR parameters of seq to v, followed by a reset unless v already ends with one.
+func style(seq string, v interface{}) string {
+	end := ""
+	s, ok := v.(string)
+	if !ok || !strings.HasSuffix(s, ResetCode) {
+		end = ResetCode
 	}
+	return fmt.Sprintf("%s%sm%v%s", esc, seq, v, end)
 }
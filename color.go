@@ -0,0 +1,372 @@
+package promptui
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/chzyer/readline"
+)
+
+// ColorProfile is the range of colors a terminal can display. Colors outside of the profile are downgraded to
+// the nearest one within it. The zero value stands for the DefaultColorProfile.
+type ColorProfile int
+
+// The possible color profiles of terminals, from the most limited to the richest.
+const (
+	// NoColor is the profile of terminals displaying no styles at all, text being rendered without any escape
+	// sequence.
+	NoColor ColorProfile = iota + 1
+
+	// ANSI is the profile of terminals displaying the 8 basic colors and their bright variants.
+	ANSI
+
+	// ANSI256 is the profile of terminals displaying the 256 colors palette.
+	ANSI256
+
+	// TrueColor is the profile of terminals displaying any 24-bit RGB color.
+	TrueColor
+)
+
+// DefaultColorProfile is the color profile of the prompts which do not set their own. When it is not set, the
+// profile of each prompt is detected from its output by DetectColorProfile.
+var DefaultColorProfile ColorProfile
+
+// DetectColorProfile returns the color profile of the terminal out writes to, defaulting to the standard output.
+// Colors are disabled when the NO_COLOR environment variable is set, when TERM is dumb or when out is not a
+// terminal, unless CLICOLOR_FORCE is set to anything but 0. Otherwise, the profile is the one advertised by the
+// COLORTERM and TERM environment variables.
+func DetectColorProfile(out io.Writer) ColorProfile {
+	if os.Getenv("NO_COLOR") != "" {
+		return NoColor
+	}
+
+	force := os.Getenv("CLICOLOR_FORCE")
+	if force == "" || force == "0" {
+		if strings.ToLower(os.Getenv("TERM")) == "dumb" || !isTerminal(out) {
+			return NoColor
+		}
+	}
+
+	switch strings.ToLower(os.Getenv("COLORTERM")) {
+	case "truecolor", "24bit":
+		return TrueColor
+	}
+
+	// Windows Terminal supports 24-bit colors but does not set COLORTERM.
+	if os.Getenv("WT_SESSION") != "" {
+		return TrueColor
+	}
+
+	term := strings.ToLower(os.Getenv("TERM"))
+	switch {
+	case strings.Contains(term, "truecolor"), strings.Contains(term, "direct"):
+		return TrueColor
+	case strings.Contains(term, "256color"):
+		return ANSI256
+	}
+
+	return ANSI
+}
+
+// isTerminal reports whether out writes to a terminal, defaulting to the standard output.
+func isTerminal(out io.Writer) bool {
+	if out == nil {
+		out = os.Stdout
+	}
+
+	f, ok := out.(fileDescriptor)
+	return ok && readline.IsTerminal(int(f.Fd()))
+}
+
+// Color is an extended color of text, either one of the 256 colors palette or a 24-bit RGB color. Colors are
+// rendered within the color profile of the prompt displaying them, downgraded to the nearest supported color
+// when needed.
+type Color struct {
+	rgb     bool
+	index   uint8
+	r, g, b uint8
+}
+
+// Color256 returns the color at the given index of the 256 colors palette.
+func Color256(index uint8) Color {
+	return Color{index: index}
+}
+
+// RGB returns the 24-bit color with the given red, green and blue components.
+func RGB(r, g, b uint8) Color {
+	return Color{rgb: true, r: r, g: g, b: b}
+}
+
+// ParseColor parses a color given either as a hex RGB string such as "#ff8800" or "#f80", or as the decimal
+// index of a color of the 256 colors palette such as "202".
+func ParseColor(s string) (Color, error) {
+	if !strings.HasPrefix(s, "#") {
+		i, err := strconv.ParseUint(s, 10, 8)
+		if err != nil {
+			return Color{}, fmt.Errorf("%q is not a color", s)
+		}
+		return Color256(uint8(i)), nil
+	}
+
+	hex := s[1:]
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil || len(hex) != 6 {
+		return Color{}, fmt.Errorf("%q is not a color", s)
+	}
+
+	return RGB(uint8(v>>16), uint8(v>>8), uint8(v)), nil
+}
+
+// FG returns a styling function coloring the text with c, like the ones returned by Styler.
+func (c Color) FG() func(interface{}) string {
+	return func(v interface{}) string {
+		return style(c.code(TrueColor, false), v)
+	}
+}
+
+// BG returns a styling function coloring the background of the text with c, like the ones returned by Styler.
+func (c Color) BG() func(interface{}) string {
+	return func(v interface{}) string {
+		return style(c.code(TrueColor, true), v)
+	}
+}
+
+// code returns the SGR parameters setting the foreground or background color to c within the given profile.
+func (c Color) code(profile ColorProfile, bg bool) string {
+	base := 38
+	if bg {
+		base = 48
+	}
+
+	switch {
+	case profile >= TrueColor && c.rgb:
+		return fmt.Sprintf("%d;2;%d;%d;%d", base, c.r, c.g, c.b)
+	case profile >= ANSI256 && c.rgb:
+		return fmt.Sprintf("%d;5;%d", base, nearest(c.r, c.g, c.b, 256))
+	case profile >= ANSI256:
+		return fmt.Sprintf("%d;5;%d", base, c.index)
+	}
+
+	i := int(c.index)
+	if c.rgb || i >= 16 {
+		r, g, b := c.components()
+		i = nearest(r, g, b, 16)
+	}
+
+	code := int(FGBlack) + i
+	if i >= 8 {
+		code = int(FGBrightBlack) + i - 8
+	}
+	if bg {
+		code += 10
+	}
+	return strconv.Itoa(code)
+}
+
+// components returns the red, green and blue components of c.
+func (c Color) components() (uint8, uint8, uint8) {
+	if c.rgb {
+		return c.r, c.g, c.b
+	}
+	return paletteColor(int(c.index))
+}
+
+// basicColors are the RGB components of the 16 basic colors, as displayed by xterm.
+var basicColors = [16][3]uint8{
+	{0, 0, 0}, {205, 0, 0}, {0, 205, 0}, {205, 205, 0}, {0, 0, 238}, {205, 0, 205}, {0, 205, 205}, {229, 229, 229},
+	{127, 127, 127}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0}, {92, 92, 255}, {255, 0, 255}, {0, 255, 255},
+	{255, 255, 255},
+}
+
+// cubeLevels are the levels of each component of the colors of the 6x6x6 cube of the 256 colors palette.
+var cubeLevels = [6]uint8{0, 95, 135, 175, 215, 255}
+
+// paletteColor returns the RGB components of the color at index i of the 256 colors palette.
+func paletteColor(i int) (uint8, uint8, uint8) {
+	switch {
+	case i < 16:
+		c := basicColors[i]
+		return c[0], c[1], c[2]
+	case i < 232:
+		i -= 16
+		return cubeLevels[i/36], cubeLevels[i/6%6], cubeLevels[i%6]
+	default:
+		gray := uint8(8 + 10*(i-232))
+		return gray, gray, gray
+	}
+}
+
+// nearest returns the index of the color of the palette, made of its first size colors, which is the nearest to
+// the given RGB color. Only the basic colors are compared for a size of 16, and only the cube and the grays
+// otherwise, as terminals often customize the basic colors.
+func nearest(r, g, b uint8, size int) int {
+	from, to := 16, size
+	if size <= 16 {
+		from = 0
+	}
+
+	best, bestDist := from, -1
+	for i := from; i < to; i++ {
+		pr, pg, pb := paletteColor(i)
+		dr, dg, db := int(r)-int(pr), int(g)-int(pg), int(b)-int(pb)
+		dist := dr*dr + dg*dg + db*db
+		if bestDist < 0 || dist < bestDist {
+			best, bestDist = i, dist
+		}
+	}
+
+	return best
+}
+
+// toColor converts the color given to the fg and bg template functions, which is either a Color, the index of a
+// color of the 256 colors palette or a string parsed by ParseColor.
+func toColor(color interface{}) (Color, error) {
+	if c, ok := color.(Color); ok {
+		return c, nil
+	}
+
+	v := reflect.ValueOf(color)
+	switch v.Kind() {
+	case reflect.String:
+		return ParseColor(v.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if i := v.Int(); i >= 0 && i <= 255 {
+			return Color256(uint8(i)), nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if i := v.Uint(); i <= 255 {
+			return Color256(uint8(i)), nil
+		}
+	}
+
+	return Color{}, fmt.Errorf("%v is not a color", color)
+}
+
+// fg is the template function coloring text with a color given to toColor, as in {{ . | fg "#ff8800" }}.
+func fg(color interface{}, v interface{}) (string, error) {
+	c, err := toColor(color)
+	if err != nil {
+		return "", err
+	}
+	return c.FG()(v), nil
+}
+
+// bg is the template function coloring the background of text with a color given to toColor, as in
+// {{ . | bg 202 }}.
+func bg(color interface{}, v interface{}) (string, error) {
+	c, err := toColor(color)
+	if err != nil {
+		return "", err
+	}
+	return c.BG()(v), nil
+}
+
+// resolveProfile returns profile, or the DefaultColorProfile when it is not set, or else the profile detected
+// from out.
+func resolveProfile(profile ColorProfile, out io.Writer) ColorProfile {
+	if profile == 0 {
+		profile = DefaultColorProfile
+	}
+	if profile == 0 {
+		profile = DetectColorProfile(out)
+	}
+	return profile
+}
+
+// profileWriter writes the output of a prompt to a terminal with a limited color profile, stripping or
+// downgrading the styles it cannot display. Each write must hold whole escape sequences, as screenbuf's do.
+type profileWriter struct {
+	w       io.Writer
+	profile ColorProfile
+}
+
+// newProfileWriter returns a writer rendering the styles written to w within profile, defaulting to the profile of
+// the terminal out writes to, or w itself when the profile displays any color.
+func newProfileWriter(w io.Writer, profile ColorProfile, out io.Writer) io.Writer {
+	profile = resolveProfile(profile, out)
+	if profile >= TrueColor {
+		return w
+	}
+	return &profileWriter{w: w, profile: profile}
+}
+
+func (w *profileWriter) Write(p []byte) (int, error) {
+	var out bytes.Buffer
+
+	for i := 0; i < len(p); {
+		if p[i] == '\033' && i+1 < len(p) && p[i+1] == '[' {
+			j := i + 2
+			for j < len(p) && (p[j] >= '0' && p[j] <= '9' || p[j] == ';') {
+				j++
+			}
+
+			// only SGR sequences, ending with m, style text. Other sequences move the cursor.
+			if j < len(p) && p[j] == 'm' {
+				if params := convertSGR(string(p[i+2:j]), w.profile); params != "" {
+					out.WriteString(esc + params + "m")
+				}
+				i = j + 1
+				continue
+			}
+		}
+
+		out.WriteByte(p[i])
+		i++
+	}
+
+	_, err := w.w.Write(out.Bytes())
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// convertSGR converts the parameters of an SGR sequence to the given profile, downgrading the extended colors.
+// It returns an empty string when no styles are displayed.
+func convertSGR(params string, profile ColorProfile) string {
+	if profile == NoColor {
+		return ""
+	}
+
+	in := strings.Split(params, ";")
+	out := make([]string, 0, len(in))
+
+	for i := 0; i < len(in); i++ {
+		if (in[i] != "38" && in[i] != "48") || i+1 >= len(in) {
+			out = append(out, in[i])
+			continue
+		}
+
+		bg := in[i] == "48"
+
+		var c Color
+		switch {
+		case in[i+1] == "5" && i+2 < len(in):
+			n, _ := strconv.Atoi(in[i+2])
+			c = Color256(uint8(n))
+			i += 2
+		case in[i+1] == "2" && i+4 < len(in):
+			r, _ := strconv.Atoi(in[i+2])
+			g, _ := strconv.Atoi(in[i+3])
+			b, _ := strconv.Atoi(in[i+4])
+			c = RGB(uint8(r), uint8(g), uint8(b))
+			i += 4
+		default:
+			out = append(out, in[i])
+			continue
+		}
+
+		out = append(out, c.code(profile, bg))
+	}
+
+	return strings.Join(out, ";")
+}
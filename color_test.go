@@ -0,0 +1,240 @@
+package promptui
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/manifoldco/promptui/promptuitest"
+)
+
+func TestParseColor(t *testing.T) {
+	tcs := []struct {
+		in  string
+		exp Color
+	}{
+		{"#ff8800", RGB(255, 136, 0)},
+		{"#F80", RGB(255, 136, 0)},
+		{"202", Color256(202)},
+	}
+
+	for _, tc := range tcs {
+		c, err := ParseColor(tc.in)
+		if err != nil {
+			t.Errorf("Unexpected error parsing %q: %v", tc.in, err)
+			continue
+		}
+		if c != tc.exp {
+			t.Errorf("expected %q to parse as %+v, got %+v", tc.in, tc.exp, c)
+		}
+	}
+
+	for _, in := range []string{"", "orange", "#ff88", "#gg8800", "256"} {
+		if _, err := ParseColor(in); err == nil {
+			t.Errorf("expected an error parsing %q", in)
+		}
+	}
+}
+
+func TestColorCode(t *testing.T) {
+	tcs := []struct {
+		name    string
+		color   Color
+		profile ColorProfile
+		bg      bool
+		exp     string
+	}{
+		{"rgb in true color", RGB(255, 136, 0), TrueColor, false, "38;2;255;136;0"},
+		{"rgb in 256 colors", RGB(255, 136, 0), ANSI256, false, "38;5;208"},
+		{"rgb in 16 colors", RGB(255, 136, 0), ANSI, false, "33"},
+		{"rgb background in 16 colors", RGB(255, 136, 0), ANSI, true, "43"},
+		{"gray in 256 colors", RGB(128, 128, 128), ANSI256, false, "38;5;244"},
+		{"index in true color", Color256(202), TrueColor, true, "48;5;202"},
+		{"index in 256 colors", Color256(202), ANSI256, false, "38;5;202"},
+		{"index in 16 colors", Color256(202), ANSI, false, "91"},
+		{"basic index in 16 colors", Color256(4), ANSI, false, "34"},
+		{"bright index in 16 colors", Color256(12), ANSI, true, "104"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.color.code(tc.profile, tc.bg); got != tc.exp {
+				t.Errorf("expected %q, got %q", tc.exp, got)
+			}
+		})
+	}
+}
+
+func TestColorFuncs(t *testing.T) {
+	execute := func(text string) (string, error) {
+		tpl, err := template.New("").Funcs(FuncMap).Parse(text)
+		if err != nil {
+			return "", err
+		}
+
+		var buf bytes.Buffer
+		err = tpl.Execute(&buf, "hi")
+		return buf.String(), err
+	}
+
+	got, err := execute(`{{ . | fg "#ff8800" }}`)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if exp := "\x1b[38;2;255;136;0mhi\x1b[0m"; got != exp {
+		t.Errorf("expected %q, got %q", exp, got)
+	}
+
+	got, err = execute(`{{ . | bg 202 | brightWhite }}`)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if exp := "\x1b[97m\x1b[48;5;202mhi\x1b[0m"; got != exp {
+		t.Errorf("expected %q, got %q", exp, got)
+	}
+
+	if _, err := execute(`{{ . | fg "orange" }}`); err == nil {
+		t.Errorf("expected an error with an invalid color")
+	}
+}
+
+func TestDetectColorProfile(t *testing.T) {
+	vars := []string{"NO_COLOR", "CLICOLOR_FORCE", "COLORTERM", "WT_SESSION", "TERM"}
+	for _, v := range vars {
+		defer os.Setenv(v, os.Getenv(v))
+	}
+
+	tcs := []struct {
+		env map[string]string
+		exp ColorProfile
+	}{
+		{map[string]string{"CLICOLOR_FORCE": "1", "COLORTERM": "truecolor", "TERM": "xterm"}, TrueColor},
+		{map[string]string{"CLICOLOR_FORCE": "1", "TERM": "xterm-256color"}, ANSI256},
+		{map[string]string{"CLICOLOR_FORCE": "1", "TERM": "xterm-direct"}, TrueColor},
+		{map[string]string{"CLICOLOR_FORCE": "1", "TERM": "xterm"}, ANSI},
+		{map[string]string{"CLICOLOR_FORCE": "1", "TERM": "dumb"}, ANSI},
+		{map[string]string{"NO_COLOR": "1", "CLICOLOR_FORCE": "1", "TERM": "xterm"}, NoColor},
+		{map[string]string{"CLICOLOR_FORCE": "0", "TERM": "dumb"}, NoColor},
+		// the output of tests is not a terminal.
+		{map[string]string{"COLORTERM": "truecolor", "TERM": "xterm-256color"}, NoColor},
+	}
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	for _, tc := range tcs {
+		for _, v := range vars {
+			os.Setenv(v, tc.env[v])
+		}
+
+		if got := DetectColorProfile(nil); got != tc.exp {
+			t.Errorf("expected profile %d with %v, got %d", tc.exp, tc.env, got)
+		}
+
+		if got := DetectColorProfile(w); got != tc.exp {
+			t.Errorf("expected profile %d of a pipe with %v, got %d", tc.exp, tc.env, got)
+		}
+	}
+}
+
+func TestProfileWriter(t *testing.T) {
+	in := "\x1b[1A\x1b[2K\x1b[1;38;2;255;136;0mhi\x1b[0m \x1b[48;5;202mthere\x1b[0m"
+
+	tcs := []struct {
+		profile ColorProfile
+		exp     string
+	}{
+		{TrueColor, in},
+		{ANSI256, "\x1b[1A\x1b[2K\x1b[1;38;5;208mhi\x1b[0m \x1b[48;5;202mthere\x1b[0m"},
+		{ANSI, "\x1b[1A\x1b[2K\x1b[1;33mhi\x1b[0m \x1b[101mthere\x1b[0m"},
+		{NoColor, "\x1b[1A\x1b[2Khi there"},
+	}
+
+	for _, tc := range tcs {
+		var buf bytes.Buffer
+
+		w := newProfileWriter(&buf, tc.profile, nil)
+		n, err := w.Write([]byte(in))
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		if n != len(in) {
+			t.Errorf("expected %d bytes written with profile %d, got %d", len(in), tc.profile, n)
+		}
+
+		if got := buf.String(); got != tc.exp {
+			t.Errorf("expected %q with profile %d, got %q", tc.exp, tc.profile, got)
+		}
+	}
+}
+
+func TestPromptColorProfile(t *testing.T) {
+	term := promptuitest.New()
+	term.Type("gopher", promptuitest.Enter)
+
+	p := Prompt{
+		Label:        "Name",
+		ColorProfile: NoColor,
+		Stdin:        term.Stdin(),
+		Stdout:       term.Stdout(),
+	}
+
+	value, err := p.Run()
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if value != "gopher" {
+		t.Errorf("expected %q, got %q", "gopher", value)
+	}
+
+	if out := term.Output(); strings.Contains(out, "\x1b[0m") || strings.Contains(out, "\x1b[1m") {
+		t.Errorf("expected no styles in the output, got %q", out)
+	}
+
+	if screen := term.Screen(); !strings.Contains(screen, "Name: gopher") {
+		t.Errorf("expected the answer on screen, got %q", screen)
+	}
+}
+
+func TestDefaultColorProfile(t *testing.T) {
+	defer func(p ColorProfile) { DefaultColorProfile = p }(DefaultColorProfile)
+
+	DefaultColorProfile = NoColor
+
+	if got, exp := Styler(FGRed)("hi"), "\x1b[31mhi\x1b[0m"; got != exp {
+		t.Errorf("expected Styler to keep its styles, got %q", got)
+	}
+
+	run := func(profile ColorProfile) string {
+		term := promptuitest.New()
+		term.Type("gopher", promptuitest.Enter)
+
+		p := Prompt{
+			Label:        "Name",
+			ColorProfile: profile,
+			Stdin:        term.Stdin(),
+			Stdout:       term.Stdout(),
+		}
+
+		_, err := p.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		return term.Output()
+	}
+
+	if out := run(0); strings.Contains(out, "\x1b[1m") {
+		t.Errorf("expected no styles with the default profile, got %q", out)
+	}
+
+	if out := run(TrueColor); !strings.Contains(out, "\x1b[1m") {
+		t.Errorf("expected the profile of the prompt to keep the styles, got %q", out)
+	}
+}
@@ -0,0 +1,173 @@
+package promptui
+
+import "strings"
+
+// MultilineCursor tracks the state of the movable cursor inside a text spanning several lines. Like Cursor, the
+// input is kept pristine and the Pointer is only inserted when formatting the lines for display.
+type MultilineCursor struct {
+	// shows where the user inserts/updates text
+	Cursor Pointer
+	// the lines of text entered by the user, without line terminators
+	lines [][]rune
+	// Row is the line where the cursor is placed
+	Row int
+	// Col puts the cursor before this rune of the current line
+	Col int
+}
+
+// NewMultilineCursor creates a new multi-line cursor with the specified pointer and starting input, placed at
+// the end of the input.
+func NewMultilineCursor(startinginput string, pointer Pointer) MultilineCursor {
+	if pointer == nil {
+		pointer = defaultCursor
+	}
+	cur := MultilineCursor{Cursor: pointer}
+	cur.Replace(startinginput)
+	return cur
+}
+
+// Get returns a copy of the input, with lines separated by \n.
+func (c *MultilineCursor) Get() string {
+	lines := make([]string, len(c.lines))
+	for i, l := range c.lines {
+		lines[i] = string(l)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Len returns the number of runes of the input, including line terminators.
+func (c *MultilineCursor) Len() int {
+	n := len(c.lines) - 1
+	for _, l := range c.lines {
+		n += len(l)
+	}
+	return n
+}
+
+// LineCount returns the number of lines of the input.
+func (c *MultilineCursor) LineCount() int {
+	return len(c.lines)
+}
+
+// Replace replaces the previous input with whatever is specified, and moves the cursor to the end position.
+func (c *MultilineCursor) Replace(input string) {
+	c.lines = nil
+	for _, l := range strings.Split(input, "\n") {
+		c.lines = append(c.lines, []rune(l))
+	}
+	c.Row = len(c.lines) - 1
+	c.Col = len(c.lines[c.Row])
+}
+
+// Update inserts newinput at the cursor position, splitting lines on \n. The cursor is moved to the end of the
+// inserted text.
+func (c *MultilineCursor) Update(newinput string) {
+	for i, part := range strings.Split(newinput, "\n") {
+		if i > 0 {
+			c.Newline()
+		}
+
+		line := c.lines[c.Row]
+		b := []rune(part)
+		line = append(line[:c.Col], append(b, line[c.Col:]...)...)
+		c.lines[c.Row] = line
+		c.Col += len(b)
+	}
+}
+
+// Newline splits the current line at the cursor position and moves the cursor to the start of the new line.
+func (c *MultilineCursor) Newline() {
+	line := c.lines[c.Row]
+	head := append([]rune{}, line[:c.Col]...)
+	tail := append([]rune{}, line[c.Col:]...)
+
+	lines := append([][]rune{}, c.lines[:c.Row]...)
+	lines = append(lines, head, tail)
+	c.lines = append(lines, c.lines[c.Row+1:]...)
+
+	c.Row++
+	c.Col = 0
+}
+
+// Backspace removes the rune that precedes the cursor, joining the current line with the previous one when the
+// cursor is at the start of a line.
+func (c *MultilineCursor) Backspace() {
+	if c.Col > 0 {
+		line := c.lines[c.Row]
+		c.lines[c.Row] = append(line[:c.Col-1], line[c.Col:]...)
+		c.Col--
+		return
+	}
+
+	if c.Row == 0 {
+		return
+	}
+
+	prev := c.lines[c.Row-1]
+	c.Col = len(prev)
+	c.lines[c.Row-1] = append(prev, c.lines[c.Row]...)
+	c.lines = append(c.lines[:c.Row], c.lines[c.Row+1:]...)
+	c.Row--
+}
+
+// Move moves the cursor over in relative terms by shift runes, wrapping to the previous or next line when
+// reaching the start or end of a line.
+func (c *MultilineCursor) Move(shift int) {
+	for ; shift > 0; shift-- {
+		switch {
+		case c.Col < len(c.lines[c.Row]):
+			c.Col++
+		case c.Row < len(c.lines)-1:
+			c.Row++
+			c.Col = 0
+		}
+	}
+
+	for ; shift < 0; shift++ {
+		switch {
+		case c.Col > 0:
+			c.Col--
+		case c.Row > 0:
+			c.Row--
+			c.Col = len(c.lines[c.Row])
+		}
+	}
+}
+
+// MoveLine moves the cursor up or down by shift lines, keeping its column when the destination line is long
+// enough.
+func (c *MultilineCursor) MoveLine(shift int) {
+	c.Row += shift
+	if c.Row < 0 {
+		c.Row = 0
+	}
+	if c.Row > len(c.lines)-1 {
+		c.Row = len(c.lines) - 1
+	}
+	if c.Col > len(c.lines[c.Row]) {
+		c.Col = len(c.lines[c.Row])
+	}
+}
+
+// LineStart moves the cursor to the start of the current line.
+func (c *MultilineCursor) LineStart() {
+	c.Col = 0
+}
+
+// LineEnd moves the cursor to the end of the current line.
+func (c *MultilineCursor) LineEnd() {
+	c.Col = len(c.lines[c.Row])
+}
+
+// Format renders each line of the input, with the Cursor inserted on the line where it is placed.
+func (c *MultilineCursor) Format() []string {
+	lines := make([]string, len(c.lines))
+	for i, l := range c.lines {
+		if i == c.Row {
+			lines[i] = format(l, &Cursor{Cursor: c.Cursor, Position: c.Col})
+		} else {
+			lines[i] = string(l)
+		}
+	}
+	return lines
+}
@@ -0,0 +1,91 @@
+package promptui
+
+import "testing"
+
+func TestMultilineCursor(t *testing.T) {
+	format := func(c *MultilineCursor) string {
+		out := ""
+		for i, l := range c.Format() {
+			if i > 0 {
+				out += "\n"
+			}
+			out += l
+		}
+		return out
+	}
+
+	t.Run("splits the starting input on line breaks", func(t *testing.T) {
+		cur := NewMultilineCursor("one\ntwo", pipeCursor)
+
+		if cur.LineCount() != 2 {
+			t.Errorf("expected 2 lines, got %d", cur.LineCount())
+		}
+
+		if f := format(&cur); f != "one\ntwo|" {
+			t.Errorf("%q!=%q", "one\ntwo|", f)
+		}
+
+		if cur.Len() != 7 {
+			t.Errorf("expected length 7, got %d", cur.Len())
+		}
+	})
+
+	t.Run("inserts new lines in the middle of a line", func(t *testing.T) {
+		cur := NewMultilineCursor("onetwo", pipeCursor)
+		cur.Move(-3)
+		cur.Newline()
+		cur.Update("2\n")
+
+		if got := cur.Get(); got != "one\n2\ntwo" {
+			t.Errorf("%q!=%q", "one\n2\ntwo", got)
+		}
+
+		if f := format(&cur); f != "one\n2\n|two" {
+			t.Errorf("%q!=%q", "one\n2\n|two", f)
+		}
+	})
+
+	t.Run("joins lines with backspace", func(t *testing.T) {
+		cur := NewMultilineCursor("one\ntwo", pipeCursor)
+		cur.LineStart()
+		cur.Backspace()
+
+		if f := format(&cur); f != "one|two" {
+			t.Errorf("%q!=%q", "one|two", f)
+		}
+
+		cur.MoveLine(-1)
+		cur.LineStart()
+		cur.Backspace()
+
+		if f := format(&cur); f != "|onetwo" {
+			t.Errorf("%q!=%q", "|onetwo", f)
+		}
+	})
+
+	t.Run("moves across lines", func(t *testing.T) {
+		cur := NewMultilineCursor("long line\nab\nlonger line", pipeCursor)
+		cur.MoveLine(-1)
+
+		if cur.Row != 1 || cur.Col != 2 {
+			t.Errorf("expected cursor at 1:2, got %d:%d", cur.Row, cur.Col)
+		}
+
+		cur.Move(1)
+		if cur.Row != 2 || cur.Col != 0 {
+			t.Errorf("expected cursor at 2:0, got %d:%d", cur.Row, cur.Col)
+		}
+
+		cur.Move(-1)
+		cur.MoveLine(-5)
+		if cur.Row != 0 || cur.Col != 2 {
+			t.Errorf("expected cursor at 0:2, got %d:%d", cur.Row, cur.Col)
+		}
+
+		cur.LineEnd()
+		cur.MoveLine(5)
+		if cur.Row != 2 || cur.Col != 9 {
+			t.Errorf("expected cursor at 2:9, got %d:%d", cur.Row, cur.Col)
+		}
+	})
+}
@@ -0,0 +1,301 @@
+package promptui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/chzyer/readline"
+	"github.com/manifoldco/promptui/screenbuf"
+)
+
+// Editor represents a prompt for long-form input which is edited in an external text editor. The label is
+// displayed until the user presses enter, then the editor is launched on a temporary file holding the current
+// value and the prompt waits for the editor to exit before reading the value back.
+type Editor struct {
+	// Label is the value displayed on the command line prompt.
+	//
+	// The value for Label can be a simple string or a struct that will need to be accessed by dot notation
+	// inside the templates. For example, `{{ .Name }}` will display the name property of a struct.
+	Label interface{}
+
+	// ID identifies the prompt when looking up preset answers. When the Answers provider has an answer for the ID,
+	// Run returns it without launching the editor. See the AnswerProvider docs for more info.
+	ID string
+
+	// Default is the initial content of the file opened in the editor.
+	Default string
+
+	// Validate is an optional function that will be used against the edited text to validate it. When the text
+	// is invalid, the error is displayed and the editor is opened again on the text once the user presses enter.
+	Validate ValidateFunc
+
+	// Command is the editor command to run, with optional arguments such as "code --wait". The path of the file
+	// to edit is appended to the arguments. Defaults to the VISUAL environment variable, then EDITOR, then
+	// DefaultEditor.
+	Command string
+
+	// Extension is the extension of the temporary file opened in the editor, such as ".md" or ".yaml". It lets
+	// editors pick the right syntax highlighting.
+	Extension string
+
+	// HideEntered sets whether to hide the text after the user has finished editing it.
+	HideEntered bool
+
+	// Templates can be used to customize the prompt output. If nil is passed, the default templates are used.
+	// The Prompt and Invalid templates render the label while the user is asked to launch the editor and the
+	// Success template renders it once the text is valid. See the PromptTemplates docs for more info.
+	Templates *PromptTemplates
+
+	// Theme sets the icons, styles and default templates of the prompt. It defaults to DefaultTheme. See the Theme
+	// docs for more info.
+	Theme *Theme
+
+	// ColorProfile limits the styles displayed by the prompt, the ones beyond it being downgraded or stripped. It
+	// defaults to DefaultColorProfile, or to the profile detected from Stdout.
+	ColorProfile ColorProfile
+
+	// Accessible runs the prompt in accessible mode, printing plain text lines instead of redrawing its output
+	// around the launches of the editor. It is enabled for all of them by the Accessible variable.
+	Accessible bool
+
+	Stdin  io.ReadCloser
+	Stdout io.WriteCloser
+}
+
+// Run executes the editor prompt. It displays the label and launches the editor when the user presses enter,
+// as many times as needed for the edited text to be valid. It returns the text without its trailing line
+// breaks and an error if any occurred during the prompt's execution or if the editor failed.
+func (e *Editor) Run() (string, error) {
+	return e.RunContext(context.Background())
+}
+
+// RunContext executes the editor prompt like Run, but stops it as soon as the given context is done while
+// the user is asked to launch the editor. When that happens, the prompt is cleared from the terminal and the
+// context's error is returned.
+func (e *Editor) RunContext(ctx context.Context) (string, error) {
+	p := Prompt{Label: e.Label, Templates: e.Templates, Theme: e.Theme}
+
+	err := p.prepareTemplates()
+	if err != nil {
+		return "", err
+	}
+
+	e.Templates = p.Templates
+
+	if answer, ok := presetAnswer(e.ID); ok {
+		return e.answer(answer)
+	}
+
+	if e.Accessible || Accessible {
+		return e.runAccessible(ctx)
+	}
+
+	if !isInteractive(e.Stdin) {
+		ta := TextArea{
+			Label:    e.Label,
+			Default:  e.Default,
+			Validate: e.Validate,
+			Stdin:    e.Stdin,
+			Stdout:   e.Stdout,
+		}
+		return ta.runLine()
+	}
+
+	c := &readline.Config{
+		Stdin:          e.Stdin,
+		Stdout:         e.Stdout,
+		HistoryLimit:   -1,
+		UniqueEditLine: true,
+	}
+
+	err = c.Init()
+	if err != nil {
+		return "", err
+	}
+
+	stdin := readline.NewCancelableStdin(c.Stdin)
+	c.Stdin = stdin
+
+	rl, err := readline.NewEx(c)
+	if err != nil {
+		return "", err
+	}
+
+	rl.Write([]byte(hideCursor))
+	sb := screenbuf.New(newProfileWriter(rl, e.ColorProfile, e.Stdout))
+
+	stop := cancelOnDone(ctx, stdin)
+	defer stop()
+
+	validFn := func(x string) error {
+		return nil
+	}
+	if e.Validate != nil {
+		validFn = e.Validate
+	}
+
+	var inputErr error
+	text := e.Default
+	muted := resolveTheme(e.Theme).Styles.Muted
+
+	c.SetListener(func(line []rune, pos int, key rune) ([]rune, int, bool) {
+		if key == KeyEnter {
+			return nil, 0, true
+		}
+
+		prompt := render(e.Templates.prompt, e.Label)
+		if inputErr != nil {
+			prompt = render(e.Templates.invalid, e.Label)
+		}
+		prompt = append(prompt, muted("[enter to launch editor]")...)
+
+		sb.SetWidth(terminalWidth(e.Stdout))
+		sb.Reset()
+		sb.Write(prompt)
+		if inputErr != nil {
+			sb.Write(render(e.Templates.validation, inputErr))
+		}
+		sb.Flush()
+		return nil, 0, true
+	})
+
+	for {
+		_, err = rl.Readline()
+		if err != nil {
+			break
+		}
+
+		text, err = e.edit(text)
+		if err != nil {
+			break
+		}
+
+		inputErr = validFn(text)
+		if inputErr == nil {
+			break
+		}
+	}
+
+	if err != nil {
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		} else if err == readline.ErrInterrupt || err.Error() == "Interrupt" {
+			err = ErrInterrupt
+		} else if err == io.EOF {
+			err = ErrEOF
+		}
+
+		clearScreen(sb)
+		rl.Write([]byte(showCursor))
+		rl.Close()
+		return "", err
+	}
+
+	if e.HideEntered {
+		clearScreen(sb)
+	} else {
+		summary := text
+		if i := strings.Index(summary, "\n"); i >= 0 {
+			summary = summary[:i] + " …"
+		}
+
+		sb.SetWidth(terminalWidth(e.Stdout))
+		sb.Reset()
+		sb.Write(append(render(e.Templates.success, e.Label), summary...))
+		sb.Flush()
+	}
+
+	rl.Write([]byte(showCursor))
+	rl.Close()
+
+	return text, nil
+}
+
+// edit launches the editor on a temporary file holding text and returns the content of the file once the
+// editor exits. The file ends with a line break like text files usually do, which is trimmed when reading the
+// content back.
+func (e *Editor) edit(text string) (string, error) {
+	if text != "" && !strings.HasSuffix(text, "\n") {
+		text += "\n"
+	}
+
+	f, err := ioutil.TempFile("", "promptui*"+e.Extension)
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.WriteString(text)
+	if err == nil {
+		err = f.Close()
+	}
+	if err != nil {
+		f.Close()
+		return "", err
+	}
+
+	args := strings.Fields(e.command())
+	if len(args) == 0 {
+		return "", errors.New("no editor command")
+	}
+
+	cmd := exec.Command(args[0], append(args[1:], f.Name())...)
+
+	// The editor needs the terminal itself rather than the prompt's streams, unless those are the terminal.
+	cmd.Stdin = os.Stdin
+	if in, ok := e.Stdin.(*os.File); ok {
+		cmd.Stdin = in
+	}
+	cmd.Stdout = os.Stdout
+	if out, ok := e.Stdout.(*os.File); ok {
+		cmd.Stdout = out
+	}
+	cmd.Stderr = os.Stderr
+
+	err = cmd.Run()
+	if err != nil {
+		return "", fmt.Errorf("editor %s failed: %v", args[0], err)
+	}
+
+	b, err := ioutil.ReadFile(f.Name())
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(string(b), "\r\n"), nil
+}
+
+func (e *Editor) command() string {
+	if e.Command != "" {
+		return e.Command
+	}
+
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if cmd := os.Getenv(env); cmd != "" {
+			return cmd
+		}
+	}
+
+	return DefaultEditor
+}
+
+// answer checks a text given without launching the editor, returning it if it is valid.
+func (e *Editor) answer(answer string) (string, error) {
+	if answer == "" {
+		answer = e.Default
+	}
+
+	if e.Validate != nil {
+		if err := e.Validate(answer); err != nil {
+			return "", err
+		}
+	}
+
+	return answer, nil
+}
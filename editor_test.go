@@ -0,0 +1,174 @@
+package promptui
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/manifoldco/promptui/promptuitest"
+)
+
+func TestEditor(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test editor is a shell script")
+	}
+
+	dir, err := ioutil.TempDir("", "promptui")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// The editor appends a line to the file and records the file name to check the extension.
+	editor := filepath.Join(dir, "editor")
+	script := "#!/bin/sh\necho \"$1\" > " + filepath.Join(dir, "name") + "\necho edited >> \"$1\"\n"
+	err = ioutil.WriteFile(editor, []byte(script), 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("reads the edited text back", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type(promptuitest.Enter)
+
+		e := Editor{
+			Label:     "Notes",
+			Default:   "draft\n",
+			Command:   editor,
+			Extension: ".md",
+			Stdin:     term.Stdin(),
+			Stdout:    term.Stdout(),
+		}
+
+		result, err := e.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if result != "draft\nedited" {
+			t.Errorf("expected %q, got %q", "draft\nedited", result)
+		}
+
+		name, err := ioutil.ReadFile(filepath.Join(dir, "name"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !strings.HasSuffix(strings.TrimSpace(string(name)), ".md") {
+			t.Errorf("expected the edited file to have the .md extension, got %s", name)
+		}
+	})
+
+	t.Run("re-opens the editor until the text is valid", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type(promptuitest.Enter, promptuitest.Enter)
+
+		e := Editor{
+			Label:   "Notes",
+			Command: editor,
+			Validate: func(input string) error {
+				if strings.Count(input, "edited") < 2 {
+					return errors.New("edit twice")
+				}
+				return nil
+			},
+			Stdin:  term.Stdin(),
+			Stdout: term.Stdout(),
+		}
+
+		result, err := e.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if result != "edited\nedited" {
+			t.Errorf("expected %q, got %q", "edited\nedited", result)
+		}
+
+		if !strings.Contains(term.Output(), "edit twice") {
+			t.Errorf("expected the validation error to be displayed")
+		}
+	})
+
+	t.Run("uses its theme and color profile", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type(promptuitest.Enter)
+
+		e := Editor{
+			Label:        "Notes",
+			Command:      editor,
+			Theme:        &Theme{Icons: Icons{Initial: "$"}},
+			ColorProfile: NoColor,
+			Stdin:        term.Stdin(),
+			Stdout:       term.Stdout(),
+		}
+
+		_, err := e.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		out := term.Output()
+		if !strings.Contains(out, "$ Notes: [enter to launch editor]") {
+			t.Errorf("expected the icon of the theme, got %q", out)
+		}
+		if strings.Contains(out, "\x1b[0m") {
+			t.Errorf("expected no styles in the output, got %q", out)
+		}
+	})
+
+	t.Run("runs in accessible mode", func(t *testing.T) {
+		stdin := pipeInput(t, "\n\n")
+		defer stdin.Close()
+
+		out := &nopWriteCloser{}
+		e := Editor{
+			Label:   "Notes",
+			Command: editor,
+			Validate: func(input string) error {
+				if strings.Count(input, "edited") < 2 {
+					return errors.New("edit twice")
+				}
+				return nil
+			},
+			Accessible: true,
+			Stdin:      stdin,
+			Stdout:     out,
+		}
+
+		result, err := e.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if result != "edited\nedited" {
+			t.Errorf("expected %q, got %q", "edited\nedited", result)
+		}
+
+		exp := "Notes: press enter to launch the editor \nError: edit twice\nNotes: press enter to launch the editor \n"
+		if got := out.String(); got != exp {
+			t.Errorf("expected output %q, got %q", exp, got)
+		}
+	})
+
+	t.Run("fails when the editor fails", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type(promptuitest.Enter)
+
+		e := Editor{
+			Label:   "Notes",
+			Command: "false",
+			Stdin:   term.Stdin(),
+			Stdout:  term.Stdout(),
+		}
+
+		_, err := e.Run()
+		if err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+}
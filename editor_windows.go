@@ -0,0 +1,7 @@
+// +build windows
+
+package promptui
+
+// DefaultEditor is the command launched by editor prompts when neither the Editor field nor the VISUAL and
+// EDITOR environment variables are set.
+var DefaultEditor = "notepad"
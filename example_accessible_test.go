@@ -0,0 +1,25 @@
+package promptui
+
+import (
+	"fmt"
+)
+
+// This example shows a select in accessible mode, which prints its items as a numbered list and asks for the
+// number of the chosen one, without redrawing its output. Set the Accessible variable, or the PROMPTUI_ACCESSIBLE
+// environment variable, to enable it for all prompts and selects.
+func ExampleSelect_accessible() {
+	prompt := Select{
+		Label:      "Select Day",
+		Items:      []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"},
+		Accessible: true,
+	}
+
+	_, result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("You choose %q\n", result)
+}
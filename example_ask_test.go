@@ -0,0 +1,28 @@
+package promptui
+
+import (
+	"fmt"
+	"time"
+)
+
+// This example shows how to prompt for the fields of a struct configured with tags. Strings and numbers are asked
+// with prompts, the region with a select and the debug flag with a confirm prompt.
+func ExampleAsk() {
+	type config struct {
+		Host    string        `promptui:"label=Host name,validate=required"`
+		Port    int           `promptui:"validate=port,default=8080"`
+		Region  string        `promptui:"options=eu-west|us-east|ap-south"`
+		Timeout time.Duration `promptui:"default=30s"`
+		Debug   bool
+	}
+
+	var c config
+	err := Ask(&c)
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("Connecting to %s:%d in %s\n", c.Host, c.Port, c.Region)
+}
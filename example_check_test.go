@@ -0,0 +1,39 @@
+package promptui
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// This example shows a prompt checking whether a project name is free with a slow lookup. The lookup runs in the
+// background once the user stops typing, so typing is never blocked, and the name can only be submitted once it
+// is known to be free.
+func ExamplePrompt_validateAsync() {
+	taken := map[string]bool{"api": true, "web": true}
+
+	prompt := Prompt{
+		Label: "Project name",
+		ValidateAsync: func(ctx context.Context, input string) error {
+			select {
+			case <-time.After(500 * time.Millisecond):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+
+			if taken[input] {
+				return fmt.Errorf("%s is already taken", input)
+			}
+			return nil
+		},
+	}
+
+	result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("Creating project %s\n", result)
+}
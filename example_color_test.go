@@ -0,0 +1,49 @@
+package promptui
+
+import (
+	"fmt"
+)
+
+// This example shows a select using RGB and 256 colors palette colors in its templates. On terminals with fewer
+// colors, they are downgraded to the nearest ones available.
+func ExampleColor() {
+	templates := &SelectTemplates{
+		Label:    `{{ "?" | fg "#ff8800" }} {{ . | bold }}`,
+		Active:   `{{ "▸" | fg "#ff8800" }} {{ . | fg "#ff8800" | underline }}`,
+		Inactive: `  {{ . | fg 245 }}`,
+		Selected: `{{ "✔" | fg "#ff8800" }} {{ . | faint }}`,
+	}
+
+	prompt := Select{
+		Label:     "Flavor",
+		Items:     []string{"Orange", "Lemon", "Lime"},
+		Templates: templates,
+	}
+
+	_, result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("You chose %s\n", result)
+}
+
+// This example shows a prompt rendered without any styles whatever the terminal, as done in tests comparing its
+// output. Set DefaultColorProfile instead to change the styles of all prompts.
+func ExamplePrompt_colorProfile() {
+	prompt := Prompt{
+		Label:        "Name",
+		ColorProfile: NoColor,
+	}
+
+	result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("You answered %s\n", result)
+}
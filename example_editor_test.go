@@ -0,0 +1,34 @@
+package promptui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// This example shows an editor prompt for release notes written in markdown. The editor set in the VISUAL or
+// EDITOR environment variables is launched on a .md file starting with a template to fill in.
+func ExampleEditor() {
+	validate := func(input string) error {
+		if strings.Contains(input, "TODO") {
+			return errors.New("Release notes still contain TODO")
+		}
+		return nil
+	}
+
+	prompt := Editor{
+		Label:     "Release notes",
+		Default:   "## Added\n\nTODO\n",
+		Validate:  validate,
+		Extension: ".md",
+	}
+
+	result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("Release notes:\n%s\n", result)
+}
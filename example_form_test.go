@@ -0,0 +1,40 @@
+package promptui
+
+import "fmt"
+
+// This example shows a form asking for the settings of a new service. The port is only asked for web services
+// and the user can press ctrl+x to go back to the previous question at any time.
+func ExampleForm() {
+	type service struct {
+		Name  string
+		Kind  string
+		Port  int
+		Start bool `promptui:"name=start"`
+	}
+
+	form := Form{
+		Questions: []Question{
+			{Name: "Name", Prompt: &Prompt{Label: "Name"}},
+			{Name: "Kind", Prompt: &Select{Label: "Kind", Items: []string{"web", "worker", "cron"}}},
+			{
+				Name:   "Port",
+				Prompt: &Prompt{Label: "Port", Default: "8080"},
+				Skip: func(answers map[string]interface{}) bool {
+					return answers["Kind"] != "web"
+				},
+			},
+			{Name: "start", Prompt: &Prompt{Label: "Start now", IsConfirm: true}},
+		},
+		Summary: `{{ "Creating" | faint }} {{ .Name | bold }} ({{ .Kind }})`,
+	}
+
+	var s service
+	err := form.Run(&s)
+
+	if err != nil {
+		fmt.Printf("Form failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("Starting now: %v\n", s.Start)
+}
@@ -0,0 +1,47 @@
+package promptui
+
+import (
+	"fmt"
+
+	"github.com/manifoldco/promptui/list"
+)
+
+// This example shows a select searched with the fuzzy scorer of the list package. Typing "/" followed by "rsh"
+// ranks "Red Savina Habanero" first and the highlight function shows which letters of the names were matched.
+func ExampleSelect_fuzzy() {
+	peppers := []pepper{
+		{Name: "Bell Pepper", HeatUnit: 0},
+		{Name: "Banana Pepper", HeatUnit: 100},
+		{Name: "Poblano", HeatUnit: 1000},
+		{Name: "Habanero", HeatUnit: 100000},
+		{Name: "Red Savina Habanero", HeatUnit: 350000},
+	}
+
+	templates := &SelectTemplates{
+		Active:   "\U0001F336 {{ .Name | highlight }} ({{ .HeatUnit | red }})",
+		Inactive: "  {{ .Name | highlight }} ({{ .HeatUnit | red }})",
+		Selected: "\U0001F336 {{ .Name | cyan }}",
+	}
+
+	// The scorer matches the searched term against the same field that is highlighted inside the templates.
+	scorer := list.Fuzzy(func(index int) string {
+		return peppers[index].Name
+	})
+
+	prompt := Select{
+		Label:             "Spicy Level",
+		Items:             peppers,
+		Templates:         templates,
+		Ranker:            scorer,
+		StartInSearchMode: true,
+	}
+
+	i, _, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("You choose %s\n", peppers[i].Name)
+}
@@ -0,0 +1,35 @@
+package promptui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// This example shows a prompt remembering the hosts entered in previous runs. The arrow keys recall them and
+// ctrl+r searches them as the user types.
+func ExamplePrompt_history() {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		fmt.Printf("History failed %v\n", err)
+		return
+	}
+
+	prompt := Prompt{
+		Label: "Host",
+		ID:    "host",
+		History: &History{
+			File:  filepath.Join(home, ".myapp_history"),
+			Limit: 100,
+		},
+	}
+
+	result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("Connecting to %s\n", result)
+}
@@ -0,0 +1,25 @@
+package promptui
+
+import "fmt"
+
+// This example shows a multi select where at least one of the regions must be chosen. The first region is
+// checked when the list is first displayed and items are toggled with the space key.
+func ExampleMultiSelect() {
+	regions := []string{"us-east-1", "us-west-2", "eu-west-1", "eu-central-1", "ap-southeast-1"}
+
+	prompt := MultiSelect{
+		Label:   "Deploy to",
+		Items:   regions,
+		Checked: []int{0},
+		Min:     1,
+	}
+
+	_, result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("You choose %q\n", result)
+}
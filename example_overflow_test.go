@@ -0,0 +1,28 @@
+package promptui
+
+import (
+	"fmt"
+)
+
+// This example shows a select truncating the commit messages wider than the terminal with an ellipsis, rather
+// than wrapping them on several lines.
+func ExampleSelect_overflow() {
+	prompt := Select{
+		Label: "Commit",
+		Items: []string{
+			"Fix the redraw of selects whose items are wider than the terminal, which left stale lines behind",
+			"Add a theme with ASCII icons",
+			"Document how to drive prompts from tests with the promptuitest package and golden files",
+		},
+		Overflow: OverflowTruncate,
+	}
+
+	_, result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("You choose %q\n", result)
+}
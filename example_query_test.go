@@ -0,0 +1,48 @@
+package promptui
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// This example shows a select searching a package registry as the user types. The registry is queried once the
+// user stops typing for 300 milliseconds, and requests for previous terms are canceled through their context.
+func ExampleSelect_query() {
+	query := func(ctx context.Context, term string) (interface{}, error) {
+		u := "https://registry.example.com/search?q=" + url.QueryEscape(term)
+
+		req, err := http.NewRequest("GET", u, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		var names []string
+		err = json.NewDecoder(resp.Body).Decode(&names)
+		return names, err
+	}
+
+	prompt := Select{
+		Label:      "Package",
+		Query:      query,
+		QueryDelay: 300 * time.Millisecond,
+	}
+
+	_, result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("You choose %q\n", result)
+}
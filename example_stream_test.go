@@ -0,0 +1,38 @@
+package promptui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// This example shows a select listing the files of a directory tree while it is being walked. The select is
+// displayed right away and the files are appended as the walk finds them.
+func ExampleSelect_stream() {
+	files := make(chan string)
+
+	go func() {
+		defer close(files)
+		filepath.Walk(".", func(path string, info os.FileInfo, err error) error {
+			if err == nil && !info.IsDir() {
+				files <- path
+			}
+			return nil
+		})
+	}()
+
+	prompt := Select{
+		Label: "File",
+		Items: files,
+		Size:  10,
+	}
+
+	_, result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("You choose %q\n", result)
+}
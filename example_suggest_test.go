@@ -0,0 +1,35 @@
+package promptui
+
+import (
+	"fmt"
+	"strings"
+)
+
+// This example shows a prompt suggesting git commands as the user types. The arrow keys move between the
+// suggestions and tab completes the input with the selected one.
+func ExamplePrompt_suggest() {
+	commands := []string{"add", "bisect", "branch", "checkout", "cherry-pick", "clone", "commit", "diff",
+		"fetch", "merge", "pull", "push", "rebase", "reset", "status"}
+
+	prompt := Prompt{
+		Label: "Command",
+		Suggest: func(input string) []string {
+			var result []string
+			for _, c := range commands {
+				if input != "" && strings.HasPrefix(c, input) {
+					result = append(result, c)
+				}
+			}
+			return result
+		},
+	}
+
+	result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("You chose git %s\n", result)
+}
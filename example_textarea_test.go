@@ -0,0 +1,37 @@
+package promptui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// This example shows a text area asking for a commit message. Enter starts a new line and ctrl+d submits the
+// message, which must have a summary line of at most 50 characters.
+func ExampleTextArea() {
+	validate := func(input string) error {
+		summary := strings.SplitN(input, "\n", 2)[0]
+		if strings.TrimSpace(summary) == "" {
+			return errors.New("Summary line is empty")
+		}
+		if len(summary) > 50 {
+			return errors.New("Summary line is longer than 50 characters")
+		}
+		return nil
+	}
+
+	prompt := TextArea{
+		Label:    "Commit message",
+		Validate: validate,
+		MaxLines: 20,
+	}
+
+	result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("You wrote:\n%s\n", result)
+}
@@ -0,0 +1,38 @@
+package promptui
+
+import (
+	"fmt"
+)
+
+// This example shows a theme giving prompts and selects a branded look. Setting it as the DefaultTheme applies it
+// to all of them, while the Theme field of a prompt or a select applies it to that one only.
+func ExampleTheme() {
+	DefaultTheme = &Theme{
+		Icons: Icons{
+			Initial: "»",
+			Select:  "❯",
+		},
+		IconStyles: IconStyles{
+			Initial: Styler(FGMagenta),
+			Select:  Styler(FGMagenta),
+		},
+		Styles: Styles{
+			Label:  Styler(FGMagenta, FGBold),
+			Active: Styler(FGMagenta),
+		},
+	}
+
+	prompt := Select{
+		Label: "Region",
+		Items: []string{"us-east-1", "eu-west-1", "ap-south-1"},
+	}
+
+	_, result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("You chose %s\n", result)
+}
@@ -0,0 +1,48 @@
+package promptui
+
+import (
+	"fmt"
+	"time"
+)
+
+// This example shows a prompt for a number of replicas between 1 and 10. The value is validated as the user
+// types and the arrow keys increase or decrease it.
+func ExampleIntPrompt() {
+	def, min, max := 3, 1, 10
+
+	prompt := IntPrompt{
+		Label:   "Replicas",
+		Default: &def,
+		Min:     &min,
+		Max:     &max,
+	}
+
+	replicas, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("Scaling to %d replicas\n", replicas)
+}
+
+// This example shows a prompt for a date in the future, the arrow keys moving it by a day.
+func ExampleDatePrompt() {
+	tomorrow := time.Now().AddDate(0, 0, 1)
+
+	prompt := DatePrompt{
+		Label:   "Release date",
+		Default: &tomorrow,
+		Min:     &tomorrow,
+	}
+
+	date, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("Releasing on %s\n", date.Format("Monday, January 2"))
+}
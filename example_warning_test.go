@@ -0,0 +1,34 @@
+package promptui
+
+import (
+	"fmt"
+	"strings"
+)
+
+// This example shows a prompt warning about a branch name which does not follow the naming conventions. The
+// warning is displayed below the input and, as ConfirmWarnings is set, the user must press enter twice to use
+// such a name anyway.
+func ExampleValidationWarning() {
+	prompt := Prompt{
+		Label: "Branch",
+		Validate: func(input string) error {
+			if strings.TrimSpace(input) == "" {
+				return fmt.Errorf("branch name is required")
+			}
+			if !strings.Contains(input, "/") {
+				return Warnf("branch names usually start with a prefix such as feature/")
+			}
+			return nil
+		},
+		ConfirmWarnings: true,
+	}
+
+	result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("Creating branch %s\n", result)
+}
@@ -0,0 +1,292 @@
+package promptui
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sync/atomic"
+	"text/template"
+
+	"github.com/chzyer/readline"
+)
+
+// errBack is returned by the prompts of a form when the user presses KeyBack to return to the previous question.
+var errBack = errors.New("back")
+
+// backKey is the context key of the formBack of a prompt running inside a form with a question to go back to.
+type backKey struct{}
+
+// formBack records whether the user went back to the previous question of a form.
+type formBack struct {
+	went int32
+}
+
+// wentBack returns whether the user of a prompt running with ctx went back to the previous question of a form.
+func wentBack(ctx context.Context) bool {
+	back, ok := ctx.Value(backKey{}).(*formBack)
+	return ok && atomic.LoadInt32(&back.went) == 1
+}
+
+// Question is one of the questions asked by a Form.
+type Question struct {
+	// Name identifies the answer to the question. It is the name of the struct field filled with the answer,
+	// unless a field has a `promptui:"name=..."` tag with the same name.
+	Name string
+
+	// Prompt asks the question. It must be a *Prompt, a *Select or a *MultiSelect. Prompts answer with the text
+	// entered, or with a bool when IsConfirm is set. Selects answer with the chosen item and multi selects with
+	// a slice of the checked items.
+	Prompt interface{}
+
+	// Skip is an optional function deciding whether to skip the question, given the answers to the previous
+	// questions keyed by name.
+	Skip func(answers map[string]interface{}) bool
+}
+
+// Form asks a sequence of questions and fills a struct with their answers. At any point, the user can press
+// KeyBack to return to the previous question, which is asked again with the former answer as a starting point.
+type Form struct {
+	// Questions are the questions asked in order by the form.
+	Questions []Question
+
+	// Summary is an optional text/template displayed once all the questions are answered, with the filled struct
+	// as data. For example, `{{ .Host }}:{{ .Port }}` displays the Host and Port fields of the struct.
+	Summary string
+
+	// FuncMap is a map of helper functions that can be used inside of the summary template. It defaults to
+	// promptui.FuncMap.
+	FuncMap template.FuncMap
+
+	// Theme sets the styles of the summary template, available as functions like in the templates of prompts. It
+	// defaults to DefaultTheme. See the Theme docs for more info.
+	Theme *Theme
+
+	// Stdin and Stdout are used by the questions which do not set their own. The summary is written to Stdout,
+	// which defaults to os.Stdout.
+	Stdin  io.ReadCloser
+	Stdout io.WriteCloser
+}
+
+// Run asks the questions of the form and stores their answers in the struct pointed to by v. Run stops at the
+// first error returned by a prompt, leaving v unchanged.
+func (f *Form) Run(v interface{}) error {
+	return f.RunContext(context.Background(), v)
+}
+
+// RunContext runs the form like Run, but stops it as soon as the given context is done. When that happens, the
+// current prompt is cleared from the terminal and the context's error is returned.
+func (f *Form) RunContext(ctx context.Context, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("form result %T is not a pointer to a struct", v)
+	}
+	rv = rv.Elem()
+
+	fields := make(map[string]int)
+	for i := 0; i < rv.NumField(); i++ {
+		if sf := rv.Type().Field(i); sf.PkgPath == "" {
+			fields[fieldName(sf)] = i
+		}
+	}
+
+	for _, q := range f.Questions {
+		if _, ok := fields[q.Name]; !ok {
+			return fmt.Errorf("no field of %s for question %q", rv.Type(), q.Name)
+		}
+	}
+
+	var tpl *template.Template
+	if f.Summary != "" {
+		funcs := f.FuncMap
+		if funcs == nil {
+			funcs = FuncMap
+		}
+
+		var err error
+		tpl, err = template.New("").Funcs(resolveTheme(f.Theme).funcs(funcs)).Parse(f.Summary)
+		if err != nil {
+			return err
+		}
+	}
+
+	answers := make(map[string]interface{})
+	// states holds what is needed to ask a question again with its former answer.
+	states := make(map[string]interface{})
+	// asked holds the indices of the questions answered so far, to go back to them.
+	var asked []int
+
+	for i := 0; i < len(f.Questions); {
+		q := f.Questions[i]
+
+		if q.Skip != nil && q.Skip(answers) {
+			delete(answers, q.Name)
+			delete(states, q.Name)
+			i++
+			continue
+		}
+
+		qctx := ctx
+		if len(asked) > 0 {
+			qctx = context.WithValue(ctx, backKey{}, &formBack{})
+		}
+
+		answer, state, err := f.ask(qctx, q, states[q.Name])
+		if err == errBack {
+			i = asked[len(asked)-1]
+			asked = asked[:len(asked)-1]
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		answers[q.Name] = answer
+		states[q.Name] = state
+		asked = append(asked, i)
+		i++
+	}
+
+	result := reflect.New(rv.Type()).Elem()
+	result.Set(rv)
+
+	for name, answer := range answers {
+		err := assign(result.Field(fields[name]), answer)
+		if err != nil {
+			return fmt.Errorf("question %q: %v", name, err)
+		}
+	}
+
+	rv.Set(result)
+
+	if tpl != nil {
+		out := io.Writer(f.Stdout)
+		if f.Stdout == nil {
+			out = os.Stdout
+		}
+
+		_, err := fmt.Fprintf(out, "%s\n", render(tpl, v))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ask asks a question of a form, starting from the state of its former answer if any. It returns the answer
+// along with the state to ask the question again, or errBack when the user went back to the previous question.
+func (f *Form) ask(ctx context.Context, q Question, state interface{}) (interface{}, interface{}, error) {
+	switch p := q.Prompt.(type) {
+	case *Prompt:
+		prompt := *p
+		prompt.Stdin, prompt.Stdout = f.streams(ctx, prompt.Stdin, prompt.Stdout)
+		if text, ok := state.(string); ok && !prompt.IsConfirm {
+			prompt.Default = text
+			prompt.AllowEdit = true
+		}
+
+		text, err := prompt.RunContext(ctx)
+		if prompt.IsConfirm {
+			switch err {
+			case nil:
+				return true, text, nil
+			case ErrAbort:
+				return false, text, nil
+			}
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return text, text, nil
+	case *Select:
+		s := *p
+		s.Stdin, s.Stdout = f.streams(ctx, s.Stdin, s.Stdout)
+		if idx, ok := state.(int); ok {
+			s.CursorPos = idx
+		}
+
+		idx, value, err := s.RunContext(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if items := reflect.ValueOf(s.Items); items.Kind() == reflect.Slice {
+			return items.Index(idx).Interface(), idx, nil
+		}
+		return value, idx, nil
+	case *MultiSelect:
+		ms := *p
+		ms.Stdin, ms.Stdout = f.streams(ctx, ms.Stdin, ms.Stdout)
+		if indices, ok := state.([]int); ok {
+			ms.Checked = indices
+		}
+
+		indices, _, err := ms.RunContext(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		items := reflect.ValueOf(ms.Items)
+		checked := reflect.MakeSlice(items.Type(), 0, len(indices))
+		for _, i := range indices {
+			checked = reflect.Append(checked, items.Index(i))
+		}
+
+		return checked.Interface(), indices, nil
+	default:
+		return nil, nil, fmt.Errorf("question %q has an unsupported prompt %T", q.Name, q.Prompt)
+	}
+}
+
+// streams returns the streams of a question, defaulting to the ones of the form. The input is watched for KeyBack
+// when there is a previous question to go back to, unless the question is asked without a terminal as prompts
+// then read their input line by line.
+func (f *Form) streams(ctx context.Context, stdin io.ReadCloser, stdout io.WriteCloser) (io.ReadCloser, io.WriteCloser) {
+	if stdin == nil {
+		stdin = f.Stdin
+	}
+	if stdout == nil {
+		stdout = f.Stdout
+	}
+
+	back, ok := ctx.Value(backKey{}).(*formBack)
+	if !ok || !isInteractive(stdin) {
+		return stdin, stdout
+	}
+
+	if stdin == nil {
+		stdin = readline.Stdin
+	}
+
+	return &backReader{r: stdin, back: back}, stdout
+}
+
+// backReader reads the input of a question of a form which can go back to the previous one. KeyBack is turned
+// into enter, so that readline stops reading the input as when the question is answered, and the prompt finds out
+// the user went back once it returns. The rest of the input read along with KeyBack is dropped.
+type backReader struct {
+	r    io.Reader
+	back *formBack
+}
+
+func (r *backReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+
+	if i := bytes.Index(p[:n], []byte(string(KeyBack))); i >= 0 {
+		p[i] = byte(KeyEnter)
+		n = i + 1
+		atomic.StoreInt32(&r.back.went, 1)
+	}
+
+	return n, err
+}
+
+// Close does nothing, as readline never closes the input of a prompt given to it.
+func (r *backReader) Close() error {
+	return nil
+}
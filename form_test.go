@@ -0,0 +1,139 @@
+package promptui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/manifoldco/promptui/promptuitest"
+)
+
+type account struct {
+	Name  string
+	Admin bool
+	Role  string
+	Age   int `promptui:"name=years"`
+	Teams []string
+}
+
+func accountForm(term *promptuitest.Terminal) *Form {
+	return &Form{
+		Questions: []Question{
+			{Name: "Name", Prompt: &Prompt{Label: "Name", Stdin: term.Stdin(), Stdout: term.Stdout()}},
+			{Name: "Admin", Prompt: &Prompt{Label: "Admin", IsConfirm: true, Stdin: term.Stdin(), Stdout: term.Stdout()}},
+			{
+				Name: "Role",
+				Prompt: &Select{
+					Label:  "Role",
+					Items:  []string{"developer", "designer", "manager"},
+					Stdin:  term.Stdin(),
+					Stdout: term.Stdout(),
+				},
+				Skip: func(answers map[string]interface{}) bool {
+					return answers["Admin"] == true
+				},
+			},
+			{Name: "years", Prompt: &Prompt{Label: "Age", Stdin: term.Stdin(), Stdout: term.Stdout()}},
+			{
+				Name: "Teams",
+				Prompt: &MultiSelect{
+					Label:  "Teams",
+					Items:  []string{"web", "mobile", "infra"},
+					Stdin:  term.Stdin(),
+					Stdout: term.Stdout(),
+				},
+			},
+		},
+	}
+}
+
+func TestForm(t *testing.T) {
+	t.Run("fills the struct", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type("alice", promptuitest.Enter, "n", promptuitest.Enter, promptuitest.Down, promptuitest.Enter,
+			"42", promptuitest.Enter, promptuitest.Space, promptuitest.Down, promptuitest.Down, promptuitest.Space,
+			promptuitest.Enter)
+
+		var a account
+		err := accountForm(term).Run(&a)
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if a.Name != "alice" || a.Admin || a.Role != "designer" || a.Age != 42 ||
+			strings.Join(a.Teams, ",") != "web,infra" {
+			t.Errorf("unexpected result %+v", a)
+		}
+	})
+
+	t.Run("skips questions", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type("bob", promptuitest.Enter, "y", promptuitest.Enter, "30", promptuitest.Enter, promptuitest.Enter)
+
+		var a account
+		err := accountForm(term).Run(&a)
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if a.Name != "bob" || !a.Admin || a.Role != "" || a.Age != 30 || len(a.Teams) != 0 {
+			t.Errorf("unexpected result %+v", a)
+		}
+	})
+
+	t.Run("goes back to previous questions", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type("bob", promptuitest.Enter, "y", promptuitest.Enter, "3", promptuitest.CtrlX, promptuitest.CtrlX,
+			"!", promptuitest.Enter, "n", promptuitest.Enter, promptuitest.Down, promptuitest.Down,
+			promptuitest.Enter, "30", promptuitest.Enter, promptuitest.Enter)
+
+		var a account
+		err := accountForm(term).Run(&a)
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if a.Name != "bob!" || a.Admin || a.Role != "manager" || a.Age != 30 {
+			t.Errorf("unexpected result %+v", a)
+		}
+	})
+
+	t.Run("reports conversion errors", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type("bob", promptuitest.Enter, "y", promptuitest.Enter, "old", promptuitest.Enter, promptuitest.Enter)
+
+		var a account
+		err := accountForm(term).Run(&a)
+		if err == nil || !strings.Contains(err.Error(), `"old" is not an integer`) {
+			t.Errorf("expected a conversion error, got %v", err)
+		}
+	})
+
+	t.Run("renders the summary", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type("bob", promptuitest.Enter, "y", promptuitest.Enter, "30", promptuitest.Enter, promptuitest.Enter)
+
+		form := accountForm(term)
+		form.Summary = "{{ .Name | label }} is {{ .Age | accent }}"
+		form.Stdout = term.Stdout()
+
+		var a account
+		err := form.Run(&a)
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		lines := term.Lines()
+		if last := lines[len(lines)-1]; last != "bob is 30" {
+			t.Errorf("expected the summary, got %q", lines)
+		}
+	})
+
+	t.Run("requires fields for questions", func(t *testing.T) {
+		form := &Form{Questions: []Question{{Name: "Email", Prompt: &Prompt{Label: "Email"}}}}
+
+		err := form.Run(&account{})
+		if err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+}
@@ -0,0 +1,250 @@
+package promptui
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// DefaultHistoryLimit is the number of entries kept for each prompt by a History without a Limit.
+const DefaultHistoryLimit = 500
+
+// History records the answers entered in prompts so they can be recalled later. Once set on a prompt, KeyPrev
+// and KeyNext cycle through the previous answers while KeyHistorySearch searches them backward as the user
+// types, like the reverse incremental search of shells.
+//
+// Entries are kept separately for each prompt ID, so a single History can be shared by all the prompts of a
+// program. Masked prompts and confirm prompts are never recorded. When the answer of a prompt cannot be added to
+// the history, Run returns the error along with the answer.
+type History struct {
+	// File is the path of the file where the entries are persisted, in JSON. It is read each time a prompt
+	// runs and written each time an entry is added, so the history is shared between runs of the program. If
+	// empty, the history is only kept in memory.
+	File string
+
+	// Limit is the number of entries kept for each prompt, the oldest ones being dropped first. It defaults to
+	// DefaultHistoryLimit.
+	Limit int
+
+	mu      sync.Mutex
+	entries map[string][]string
+}
+
+// Entries returns the entries recorded for the prompt identified by id, from the oldest to the most recent.
+func (h *History) Entries(id string) ([]string, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	err := h.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]string(nil), h.entries[id]...), nil
+}
+
+// Add records entry as the most recent entry of the prompt identified by id. A previous identical entry is
+// removed first, so each answer is only recalled once. Empty entries are ignored.
+func (h *History) Add(id, entry string) error {
+	if strings.TrimSpace(entry) == "" {
+		return nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	err := h.load()
+	if err != nil {
+		return err
+	}
+
+	var entries []string
+	for _, e := range h.entries[id] {
+		if e != entry {
+			entries = append(entries, e)
+		}
+	}
+	entries = append(entries, entry)
+
+	limit := h.Limit
+	if limit <= 0 {
+		limit = DefaultHistoryLimit
+	}
+	if len(entries) > limit {
+		entries = entries[len(entries)-limit:]
+	}
+
+	h.entries[id] = entries
+
+	return h.save()
+}
+
+// load reads the entries from the history file, if any.
+func (h *History) load() error {
+	if h.entries == nil {
+		h.entries = make(map[string][]string)
+	}
+
+	if h.File == "" {
+		return nil
+	}
+
+	data, err := ioutil.ReadFile(h.File)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	entries := make(map[string][]string)
+	err = json.Unmarshal(data, &entries)
+	if err != nil {
+		return err
+	}
+
+	h.entries = entries
+	return nil
+}
+
+// save writes the entries to the history file, if any. The file is replaced at once so that a program reading
+// it at the same time never sees it partially written.
+func (h *History) save() error {
+	if h.File == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(h.entries)
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(h.File), filepath.Base(h.File)+"*")
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), h.File)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+
+	return err
+}
+
+// recall browses and searches the history entries of a prompt while it runs.
+type recall struct {
+	entries []string
+
+	// index is the index of the entry displayed, or len(entries) when displaying the input typed by the user,
+	// which is kept in draft.
+	index int
+	draft string
+
+	searching bool
+	term      string
+	failed    bool
+}
+
+func newRecall(entries []string) *recall {
+	return &recall{entries: entries, index: len(entries)}
+}
+
+// current returns the entry displayed, or the draft.
+func (r *recall) current() string {
+	if r.index < len(r.entries) {
+		return r.entries[r.index]
+	}
+	return r.draft
+}
+
+// prev moves to the previous entry, keeping input as the draft when leaving it. It returns false when there is
+// no previous entry.
+func (r *recall) prev(input string) bool {
+	if r.index == 0 {
+		return false
+	}
+
+	if r.index == len(r.entries) {
+		r.draft = input
+	}
+
+	r.index--
+	return true
+}
+
+// next moves to the next entry, or back to the draft after the most recent one. It returns false when the draft
+// is already displayed.
+func (r *recall) next() bool {
+	if r.index == len(r.entries) {
+		return false
+	}
+
+	r.index++
+	return true
+}
+
+// startSearch starts a search in the entries, keeping input as the draft when it is displayed.
+func (r *recall) startSearch(input string) {
+	if r.index == len(r.entries) {
+		r.draft = input
+	}
+
+	r.searching = true
+	r.term = ""
+	r.failed = false
+}
+
+// extend adds a rune to the searched term, looking for it from the entry displayed.
+func (r *recall) extend(ch rune) {
+	r.term += string(ch)
+	r.find(r.index)
+}
+
+// shorten removes the last rune of the searched term, looking for it again from the most recent entry.
+func (r *recall) shorten() {
+	term := []rune(r.term)
+	if len(term) == 0 {
+		return
+	}
+
+	r.term = string(term[:len(term)-1])
+	r.find(len(r.entries) - 1)
+}
+
+// searchNext looks for an older entry matching the searched term.
+func (r *recall) searchNext() {
+	r.find(r.index - 1)
+}
+
+// cancelSearch ends the search, displaying the draft again.
+func (r *recall) cancelSearch() {
+	r.searching = false
+	r.index = len(r.entries)
+}
+
+// find looks for the most recent entry containing the searched term, starting at index from.
+func (r *recall) find(from int) {
+	if from >= len(r.entries) {
+		from = len(r.entries) - 1
+	}
+
+	for i := from; i >= 0; i-- {
+		if strings.Contains(r.entries[i], r.term) {
+			r.index = i
+			r.failed = false
+			return
+		}
+	}
+
+	r.failed = true
+}
@@ -0,0 +1,118 @@
+package promptui
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/manifoldco/promptui/promptuitest"
+)
+
+func TestHistory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "promptui")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "history.json")
+	h := History{File: file, Limit: 3}
+
+	for _, entry := range []string{"a", "b", "a", "", "c", "d"} {
+		err := h.Add("host", entry)
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+	}
+
+	err = h.Add("user", "root")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	entries, err := (&History{File: file}).Entries("host")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	exp := []string{"a", "c", "d"}
+	if !reflect.DeepEqual(entries, exp) {
+		t.Errorf("expected %q, got %q", exp, entries)
+	}
+}
+
+func TestPromptHistory(t *testing.T) {
+	tcs := []struct {
+		name string
+		keys []string
+		exp  string
+	}{
+		{
+			name: "cycles through entries",
+			keys: []string{"draft", promptuitest.Up, promptuitest.Up, promptuitest.Up, promptuitest.Up,
+				promptuitest.Down, promptuitest.Enter},
+			exp: "db1.example.com",
+		},
+		{
+			name: "returns to the draft",
+			keys: []string{"draft", promptuitest.Up, promptuitest.Down, promptuitest.Down, promptuitest.Enter},
+			exp:  "draft",
+		},
+		{
+			name: "searches entries",
+			keys: []string{promptuitest.CtrlR, "db", promptuitest.CtrlR, promptuitest.Enter},
+			exp:  "db1.example.com",
+		},
+		{
+			name: "searches after erasing",
+			keys: []string{promptuitest.CtrlR, "dbx", promptuitest.Backspace, "1", promptuitest.Enter},
+			exp:  "db1.example.com",
+		},
+		{
+			name: "edits the entry found",
+			keys: []string{promptuitest.CtrlR, "api", promptuitest.Left, promptuitest.Backspace, promptuitest.Enter},
+			exp:  "api.example.cm",
+		},
+		{
+			name: "cancels the search",
+			keys: []string{"draft", promptuitest.CtrlR, "api", promptuitest.CtrlG, promptuitest.Enter},
+			exp:  "draft",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			h := &History{}
+			for _, entry := range []string{"api.example.com", "db1.example.com", "db2.example.com"} {
+				h.Add("host", entry)
+			}
+
+			term := promptuitest.New()
+			term.Type(tc.keys...)
+
+			p := Prompt{
+				Label:   "Host",
+				ID:      "host",
+				History: h,
+				Stdin:   term.Stdin(),
+				Stdout:  term.Stdout(),
+			}
+
+			result, err := p.Run()
+			if err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+
+			if result != tc.exp {
+				t.Errorf("expected %q, got %q", tc.exp, result)
+			}
+
+			entries, _ := h.Entries("host")
+			if last := entries[len(entries)-1]; last != tc.exp {
+				t.Errorf("expected %q to be added to the history, got %q", tc.exp, entries)
+			}
+		})
+	}
+}
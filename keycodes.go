@@ -8,6 +8,16 @@ var (
 	// KeyEnter is the default key for submission/selection.
 	KeyEnter rune = readline.CharEnter
 
+	// KeyTab is the default key to accept the suggestion selected in prompt mode.
+	KeyTab rune = readline.CharTab
+
+	// KeyHistorySearch is the default key to search the history of a prompt.
+	KeyHistorySearch rune = readline.CharBckSearch
+
+	// KeyBack is the default key to go back to the previous question of a form. It is ctrl-x, which readline
+	// leaves unbound.
+	KeyBack rune = 24
+
 	// KeyCtrlH is the key for deleting input text.
 	KeyCtrlH rune = readline.CharCtrlH
 
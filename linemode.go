@@ -0,0 +1,262 @@
+package promptui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/chzyer/readline"
+)
+
+// When stdin is not a terminal, such as when input is piped or a program runs in CI, prompts fall back to a line
+// mode: the label is printed as plain text and the answer is read as a single line from stdin. Redirecting only
+// stdout, as in cmd | tee log, keeps the interactive prompts.
+
+// fileDescriptor is implemented by *os.File and any stream attached to a file descriptor.
+type fileDescriptor interface {
+	Fd() uintptr
+}
+
+// isInteractive reports whether the given input stream is attached to a terminal, defaulting to the standard
+// input. Streams which are not backed by a file descriptor are assumed to be driven like a terminal, as is the
+// case in tests feeding keystrokes.
+func isInteractive(in io.Reader) bool {
+	if in == nil {
+		in = os.Stdin
+	}
+
+	f, ok := in.(fileDescriptor)
+	return !ok || readline.IsTerminal(int(f.Fd()))
+}
+
+// lineStreams returns the streams to use in line mode, defaulting to the standard ones.
+func lineStreams(in io.Reader, out io.Writer) (io.Reader, io.Writer) {
+	if in == nil {
+		in = os.Stdin
+	}
+	if out == nil {
+		out = os.Stdout
+	}
+	return in, out
+}
+
+// readLine reads a single line from r without the line terminator. It reads one byte at a time so that the
+// following lines are left for the next prompts. ErrNoInput is returned if r is exhausted before anything
+// was read.
+func readLine(r io.Reader) (string, error) {
+	var line []byte
+	b := make([]byte, 1)
+
+	for {
+		n, err := r.Read(b)
+		if n > 0 {
+			if b[0] == '\n' {
+				break
+			}
+			line = append(line, b[0])
+		}
+
+		if err == io.EOF {
+			if len(line) == 0 {
+				return "", ErrNoInput
+			}
+			break
+		}
+
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return strings.TrimSuffix(string(line), "\r"), nil
+}
+
+// parseConfirm checks the answer given to a confirm prompt, using def when the answer is empty.
+func parseConfirm(answer, def string) error {
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	if answer == "" {
+		answer = strings.ToLower(def)
+	}
+
+	switch answer {
+	case "y", "yes":
+		return nil
+	case "", "n", "no":
+		return ErrAbort
+	default:
+		return fmt.Errorf("%q is not a valid answer, expected y or n", answer)
+	}
+}
+
+// matchItem finds the item matching the given answer, either by its exact value or by its position inside the
+// list starting at 1. It returns the index of the item inside the list.
+func matchItem(items reflect.Value, answer string) (int, error) {
+	answer = strings.TrimSpace(answer)
+
+	for i := 0; i < items.Len(); i++ {
+		if fmt.Sprintf("%v", items.Index(i).Interface()) == answer {
+			return i, nil
+		}
+	}
+
+	n, err := strconv.Atoi(answer)
+	if err == nil && n >= 1 && n <= items.Len() {
+		return n - 1, nil
+	}
+
+	return 0, fmt.Errorf("%q does not match any item", answer)
+}
+
+func (p *Prompt) runLine(ctx context.Context) (string, error) {
+	in, out := lineStreams(p.Stdin, p.Stdout)
+
+	if p.IsConfirm {
+		confirm := "y/N"
+		if strings.ToLower(p.Default) == "y" {
+			confirm = "Y/n"
+		}
+		fmt.Fprintf(out, "%v? [%s] ", p.Label, confirm)
+	} else {
+		fmt.Fprintf(out, "%v: ", p.Label)
+	}
+
+	answer, err := readLine(in)
+	fmt.Fprintln(out)
+	if err != nil {
+		return "", err
+	}
+
+	return p.answer(ctx, answer)
+}
+
+// answer checks an answer given without the interactive prompt, returning it if it is valid.
+func (p *Prompt) answer(ctx context.Context, answer string) (string, error) {
+	if p.IsConfirm {
+		return answer, parseConfirm(answer, p.Default)
+	}
+
+	if answer == "" {
+		answer = p.Default
+	}
+
+	if p.Validate != nil {
+		if err := p.Validate(answer); err != nil && !isWarning(err) {
+			return "", err
+		}
+	}
+
+	if p.ValidateAsync != nil {
+		if err := p.ValidateAsync(ctx, answer); err != nil && !isWarning(err) {
+			return "", err
+		}
+	}
+
+	return answer, nil
+}
+
+func (s *Select) runLine(cursorPos int) (int, string, error) {
+	in, out := lineStreams(s.Stdin, s.Stdout)
+	items := s.itemValues()
+
+	fmt.Fprintf(out, "%v\n", s.Label)
+	for i := 0; i < items.Len(); i++ {
+		fmt.Fprintf(out, "  %d) %v\n", i+1, items.Index(i).Interface())
+	}
+	fmt.Fprintf(out, "Choose 1-%d: ", items.Len())
+
+	answer, err := readLine(in)
+	fmt.Fprintln(out)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return s.answer(answer, cursorPos)
+}
+
+// runQueryLine reads the term to search from stdin and lists the items returned by the Query of the select for
+// the user to choose one.
+func (s *Select) runQueryLine(ctx context.Context, cursorPos int) (int, string, error) {
+	if err := s.queryLine(ctx); err != nil {
+		return 0, "", err
+	}
+
+	return s.runLine(cursorPos)
+}
+
+// queryLine reads the term to search from stdin and receives the items returned by the Query of the select.
+func (s *Select) queryLine(ctx context.Context) error {
+	in, out := lineStreams(s.Stdin, s.Stdout)
+
+	fmt.Fprintf(out, "%v\n%s", s.Label, SearchPrompt)
+
+	term, err := readLine(in)
+	fmt.Fprintln(out)
+	if err != nil && err != ErrNoInput {
+		return err
+	}
+
+	items, err := s.queryAll(ctx, term)
+	if err != nil {
+		return err
+	}
+
+	if items.Len() == 0 {
+		return fmt.Errorf("no results for %q", term)
+	}
+
+	s.received = items
+	return nil
+}
+
+// answer finds the item matching an answer given without the interactive list, defaulting to the item at
+// cursorPos when the answer is empty.
+func (s *Select) answer(answer string, cursorPos int) (int, string, error) {
+	if s.multi != nil {
+		return 0, "", s.multi.parse(answer)
+	}
+
+	items := s.itemValues()
+
+	idx := cursorPos
+	if strings.TrimSpace(answer) != "" {
+		var err error
+		idx, err = matchItem(items, answer)
+		if err != nil {
+			return 0, "", err
+		}
+	}
+
+	if idx < 0 || idx >= items.Len() {
+		return 0, "", fmt.Errorf("no item at position %d", idx+1)
+	}
+
+	return idx, fmt.Sprintf("%v", items.Index(idx).Interface()), nil
+}
+
+// parse checks the comma separated items given as answer, keeping the initially checked items when the answer
+// is empty.
+func (m *multiState) parse(answer string) error {
+	if strings.TrimSpace(answer) != "" {
+		checked := make(map[int]bool)
+
+		for _, a := range strings.Split(answer, ",") {
+			i, err := matchItem(m.items, a)
+			if err != nil {
+				return err
+			}
+			checked[i] = true
+		}
+
+		m.checked = checked
+	}
+
+	if m.max > 0 && len(m.checked) > m.max {
+		return fmt.Errorf("select at most %d items", m.max)
+	}
+
+	return m.validate()
+}
@@ -0,0 +1,208 @@
+package promptui
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// pipeInput returns a non terminal stdin containing the given input.
+func pipeInput(t *testing.T, input string) *os.File {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Unexpected error creating pipe %v", err)
+	}
+
+	_, err = w.WriteString(input)
+	if err != nil {
+		t.Fatalf("Unexpected error writing input %v", err)
+	}
+	w.Close()
+
+	return r
+}
+
+func TestIsInteractive(t *testing.T) {
+	stdin := pipeInput(t, "")
+	defer stdin.Close()
+
+	if isInteractive(stdin) {
+		t.Errorf("expected a pipe not to be interactive")
+	}
+
+	if !isInteractive(strings.NewReader("")) {
+		t.Errorf("expected streams without a file descriptor to be interactive")
+	}
+}
+
+func TestPromptLineMode(t *testing.T) {
+	t.Run("reads a single line", func(t *testing.T) {
+		stdin := pipeInput(t, "hello\nworld\n")
+		defer stdin.Close()
+
+		p := Prompt{Label: "Name", Stdin: stdin, Stdout: &nopWriteCloser{}}
+
+		result, err := p.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if result != "hello" {
+			t.Errorf("expected %q, got %q", "hello", result)
+		}
+
+		result, err = p.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if result != "world" {
+			t.Errorf("expected %q, got %q", "world", result)
+		}
+	})
+
+	t.Run("uses the default on empty lines", func(t *testing.T) {
+		stdin := pipeInput(t, "\r\n")
+		defer stdin.Close()
+
+		p := Prompt{Label: "Name", Default: "me", Stdin: stdin, Stdout: &nopWriteCloser{}}
+
+		result, err := p.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if result != "me" {
+			t.Errorf("expected %q, got %q", "me", result)
+		}
+	})
+
+	t.Run("validates the line", func(t *testing.T) {
+		stdin := pipeInput(t, "abc\n")
+		defer stdin.Close()
+
+		p := Prompt{
+			Label:    "Number",
+			Validate: func(string) error { return ErrAbort },
+			Stdin:    stdin,
+			Stdout:   &nopWriteCloser{},
+		}
+
+		_, err := p.Run()
+		if err != ErrAbort {
+			t.Errorf("expected %v, got %v", ErrAbort, err)
+		}
+	})
+
+	t.Run("confirms", func(t *testing.T) {
+		tcs := []struct {
+			input string
+			def   string
+			err   bool
+			abort bool
+		}{
+			{input: "y\n"},
+			{input: "YES\n"},
+			{input: "\n", def: "y"},
+			{input: "n\n", abort: true},
+			{input: "\n", abort: true},
+			{input: "maybe\n", err: true},
+		}
+
+		for _, tc := range tcs {
+			stdin := pipeInput(t, tc.input)
+
+			p := Prompt{Label: "Sure", IsConfirm: true, Default: tc.def, Stdin: stdin, Stdout: &nopWriteCloser{}}
+			_, err := p.Run()
+			stdin.Close()
+
+			switch {
+			case tc.abort && err != ErrAbort:
+				t.Errorf("expected %q to abort, got %v", tc.input, err)
+			case tc.err && (err == nil || err == ErrAbort):
+				t.Errorf("expected %q to fail, got %v", tc.input, err)
+			case !tc.abort && !tc.err && err != nil:
+				t.Errorf("expected %q to confirm, got %v", tc.input, err)
+			}
+		}
+	})
+
+	t.Run("when there is no input", func(t *testing.T) {
+		stdin := pipeInput(t, "")
+		defer stdin.Close()
+
+		p := Prompt{Label: "Name", Stdin: stdin, Stdout: &nopWriteCloser{}}
+
+		_, err := p.Run()
+		if err != ErrNoInput {
+			t.Errorf("expected %v, got %v", ErrNoInput, err)
+		}
+	})
+}
+
+func TestSelectLineMode(t *testing.T) {
+	items := []string{"Zero", "One", "Two"}
+
+	tcs := []struct {
+		scenario string
+		input    string
+		index    int
+		err      error
+	}{
+		{scenario: "by label", input: "Two\n", index: 2},
+		{scenario: "by position", input: "2\n", index: 1},
+		{scenario: "empty line", input: "\n", index: 0},
+		{scenario: "no input", input: "", err: ErrNoInput},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.scenario, func(t *testing.T) {
+			stdin := pipeInput(t, tc.input)
+			defer stdin.Close()
+
+			s := Select{Label: "Number", Items: items, Stdin: stdin, Stdout: &nopWriteCloser{}}
+
+			idx, value, err := s.Run()
+			if err != tc.err {
+				t.Fatalf("expected error %v, got %v", tc.err, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if idx != tc.index || value != items[tc.index] {
+				t.Errorf("expected %d %q, got %d %q", tc.index, items[tc.index], idx, value)
+			}
+		})
+	}
+
+	t.Run("no matching item", func(t *testing.T) {
+		stdin := pipeInput(t, "Four\n")
+		defer stdin.Close()
+
+		s := Select{Label: "Number", Items: items, Stdin: stdin, Stdout: &nopWriteCloser{}}
+
+		_, _, err := s.Run()
+		if err == nil {
+			t.Errorf("expected an error, got none")
+		}
+	})
+
+	t.Run("multi select", func(t *testing.T) {
+		stdin := pipeInput(t, "Zero, 3\n")
+		defer stdin.Close()
+
+		ms := MultiSelect{Label: "Numbers", Items: items, Stdin: stdin, Stdout: &nopWriteCloser{}}
+
+		indices, values, err := ms.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if !reflect.DeepEqual([]int{0, 2}, indices) || !reflect.DeepEqual([]string{"Zero", "Two"}, values) {
+			t.Errorf("expected [0 2] [Zero Two], got %v %v", indices, values)
+		}
+	})
+}
@@ -0,0 +1,112 @@
+package list
+
+import "unicode"
+
+// Scorer is a function signature implementing Ranker, as an alternative to Searcher which also ranks the items.
+// It is called on each items of the list and should return whether or not the item fits the searched term, a
+// score used to sort the fitting items from the highest to the lowest, and the positions of the runes of the
+// item's text that match the term so they can be highlighted.
+type Scorer func(input string, index int) (score int, positions []int, ok bool)
+
+// Bonuses and penalties added up by FuzzyMatch to score a match.
+const (
+	fuzzyMatch       = 1
+	fuzzyConsecutive = 8
+	fuzzyWordStart   = 6
+	fuzzyLeadingGap  = -1
+	fuzzyMaxGap      = -5
+)
+
+// Fuzzy returns a Scorer matching the searched term fuzzily against the text of each item, as returned by the
+// given key function. See FuzzyMatch for how the items are matched and scored.
+func Fuzzy(key func(index int) string) Scorer {
+	return func(input string, index int) (int, []int, bool) {
+		return FuzzyMatch(input, key(index))
+	}
+}
+
+// FuzzyMatch reports whether all the runes of term appear in text in the same order, ignoring case. Matches are
+// scored higher when the runes are consecutive or start words in text, and lower when they start far from the
+// beginning of text. The positions of the matched runes inside text are returned along with the score.
+//
+// An empty term matches any text with a score of 0.
+func FuzzyMatch(term, text string) (int, []int, bool) {
+	needle := []rune(term)
+	if len(needle) == 0 {
+		return 0, nil, true
+	}
+
+	haystack := []rune(text)
+
+	best := 0
+	var positions []int
+
+	// The first rune of term is tried at each of its occurrences, the following ones being matched greedily,
+	// so that a better match is not hidden by an earlier partial one.
+	for start := range haystack {
+		if !equalFold(needle[0], haystack[start]) {
+			continue
+		}
+
+		score, matched, ok := fuzzyFrom(needle, haystack, start)
+		if ok && (positions == nil || score > best) {
+			best = score
+			positions = matched
+		}
+	}
+
+	if positions == nil {
+		return 0, nil, false
+	}
+
+	return best, positions, true
+}
+
+func fuzzyFrom(needle, haystack []rune, start int) (int, []int, bool) {
+	positions := make([]int, 0, len(needle))
+
+	gap := start * fuzzyLeadingGap
+	if gap < fuzzyMaxGap {
+		gap = fuzzyMaxGap
+	}
+	score := gap
+
+	n := 0
+	for i := start; i < len(haystack) && n < len(needle); i++ {
+		if !equalFold(needle[n], haystack[i]) {
+			continue
+		}
+
+		score += fuzzyMatch
+		if n > 0 && positions[n-1] == i-1 {
+			score += fuzzyConsecutive
+		}
+		if isWordStart(haystack, i) {
+			score += fuzzyWordStart
+		}
+
+		positions = append(positions, i)
+		n++
+	}
+
+	return score, positions, n == len(needle)
+}
+
+func equalFold(a, b rune) bool {
+	return a == b || unicode.ToLower(a) == unicode.ToLower(b)
+}
+
+// isWordStart reports whether the rune at i starts a word, following a separator or as the upper case letter
+// of camelCase text.
+func isWordStart(text []rune, i int) bool {
+	if i == 0 {
+		return true
+	}
+
+	prev, cur := text[i-1], text[i]
+	if !unicode.IsLetter(prev) && !unicode.IsDigit(prev) {
+		return true
+	}
+
+	return unicode.IsLower(prev) && unicode.IsUpper(cur)
+}
@@ -0,0 +1,59 @@
+package list
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	tcs := []struct {
+		term      string
+		text      string
+		ok        bool
+		positions []int
+	}{
+		{term: "", text: "anything", ok: true},
+		{term: "abc", text: "a-b-c", ok: true, positions: []int{0, 2, 4}},
+		{term: "ABC", text: "abc", ok: true, positions: []int{0, 1, 2}},
+		{term: "cb", text: "abc", ok: false},
+		{term: "bar", text: "baz-bar", ok: true, positions: []int{4, 5, 6}},
+		{term: "ss", text: "userService", ok: true, positions: []int{1, 4}},
+		{term: "abcd", text: "abc", ok: false},
+	}
+
+	for _, tc := range tcs {
+		_, positions, ok := FuzzyMatch(tc.term, tc.text)
+		if ok != tc.ok {
+			t.Errorf("%q in %q: expected match %t, got %t", tc.term, tc.text, tc.ok, ok)
+			continue
+		}
+
+		if !reflect.DeepEqual(tc.positions, positions) {
+			t.Errorf("%q in %q: expected positions %v, got %v", tc.term, tc.text, tc.positions, positions)
+		}
+	}
+}
+
+func TestFuzzyMatchScore(t *testing.T) {
+	better := [][2]string{
+		// consecutive runes beat scattered ones
+		{"api", "api-gateway"},
+		{"api", "a-pi"},
+		// word starts beat runes inside words
+		{"gw", "git-worktree"},
+		{"gw", "bigwig"},
+		// matches near the beginning beat later ones
+		{"auth", "auth-service"},
+		{"auth", "service-auth"},
+	}
+
+	for i := 0; i < len(better); i += 2 {
+		hi, _, _ := FuzzyMatch(better[i][0], better[i][1])
+		lo, _, _ := FuzzyMatch(better[i+1][0], better[i+1][1])
+
+		if hi <= lo {
+			t.Errorf("expected %q to score higher than %q for %q, got %d and %d",
+				better[i][1], better[i+1][1], better[i][0], hi, lo)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package list
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 )
 
@@ -21,10 +22,16 @@ const NotFound = -1
 type List struct {
 	items    []*interface{}
 	scope    []*interface{}
-	cursor   int // cursor holds the index of the current selected item
-	size     int // size is the number of visible options
+	matches  [][]Span // matches holds the spans matched by the ranker for each item of the scope
+	cursor   int      // cursor holds the index of the current selected item
+	size     int      // size is the number of visible options
 	start    int
+	term     string // term is the searched term, applied to the appended items
+	searched bool
 	Searcher Searcher
+
+	// Ranker is used instead of Searcher when defined, sorting the searched list by score.
+	Ranker Ranker
 }
 
 // New creates and initializes a list of searchable items. The items attribute must be a slice type with a
@@ -63,11 +70,13 @@ func (l *List) Prev() {
 }
 
 // Search allows the list to be filtered by a given term. The list must
-// implement the searcher function signature for this functionality to work.
+// implement the searcher function signature or a ranker for this functionality to work.
 func (l *List) Search(term string) {
 	term = strings.Trim(term, " ")
 	l.cursor = 0
 	l.start = 0
+	l.term = term
+	l.searched = true
 	l.search(term)
 }
 
@@ -77,18 +86,87 @@ func (l *List) CancelSearch() {
 	l.cursor = 0
 	l.start = 0
 	l.scope = l.items
+	l.matches = nil
+	l.term = ""
+	l.searched = false
+}
+
+// Append adds items at the end of the list. If the list is being searched, the search is applied to the new
+// items as well, the current selected item staying selected.
+func (l *List) Append(items ...interface{}) {
+	var selected *interface{}
+	if l.cursor < len(l.scope) {
+		selected = l.scope[l.cursor]
+	}
+
+	for _, item := range items {
+		item := item
+		l.items = append(l.items, &item)
+	}
+
+	if !l.searched {
+		l.scope = l.items
+		return
+	}
+
+	l.search(l.term)
+
+	for i, item := range l.scope {
+		if item == selected {
+			l.SetCursor(i)
+			return
+		}
+	}
+
+	l.SetCursor(0)
+}
+
+// Replace replaces all the items of the list, canceling the current search and moving the cursor back to the
+// first item.
+func (l *List) Replace(items ...interface{}) {
+	l.items = nil
+	l.CancelSearch()
+	l.Append(items...)
+}
+
+// Len returns the number of items inside the list, whether or not they fit the current search.
+func (l *List) Len() int {
+	return len(l.items)
 }
 
 func (l *List) search(term string) {
-	var scope []*interface{}
+	var ranker Ranker = l.Searcher
+	if l.Ranker != nil {
+		ranker = l.Ranker
+	}
+
+	type ranked struct {
+		item  *interface{}
+		score int
+		spans []Span
+	}
+
+	var results []ranked
 
 	for i, item := range l.items {
-		if l.Searcher(term, i) {
-			scope = append(scope, item)
+		score, spans, ok := ranker.Rank(term, i)
+		if ok {
+			results = append(results, ranked{item: item, score: score, spans: spans})
 		}
 	}
 
-	l.scope = scope
+	// The sort is stable so that items with the same score keep their original order.
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].score > results[j].score
+	})
+
+	l.scope = make([]*interface{}, len(results))
+	l.matches = make([][]Span, len(results))
+
+	for i, r := range results {
+		l.scope[i] = r.item
+		l.matches[i] = r.spans
+	}
 }
 
 // Start returns the current render start position of the list.
@@ -201,6 +279,10 @@ func (l *List) CanPageUp() bool {
 // Index returns the index of the item currently selected inside the searched list. If no item is selected,
 // the NotFound (-1) index is returned.
 func (l *List) Index() int {
+	if l.cursor >= len(l.scope) {
+		return NotFound
+	}
+
 	selected := l.scope[l.cursor]
 
 	for i, item := range l.items {
@@ -212,6 +294,46 @@ func (l *List) Index() int {
 	return NotFound
 }
 
+// Indices returns the indices of the current visible items inside the original
+// list of items, in the same order as they are returned by Items.
+func (l *List) Indices() []int {
+	var result []int
+	max := len(l.scope)
+	end := l.start + l.size
+
+	if end > max {
+		end = max
+	}
+
+	for i := l.start; i < end; i++ {
+		for j, item := range l.items {
+			if item == l.scope[i] {
+				result = append(result, j)
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+// Matches returns the spans of text matching the searched term for each of the current visible items, in the
+// same order as they are returned by Items. It returns nil when the list is not searched.
+func (l *List) Matches() [][]Span {
+	if l.matches == nil {
+		return nil
+	}
+
+	max := len(l.scope)
+	end := l.start + l.size
+
+	if end > max {
+		end = max
+	}
+
+	return l.matches[l.start:end]
+}
+
 // Items returns a slice equal to the size of the list with the current visible
 // items and the index of the active item in this list.
 func (l *List) Items() ([]interface{}, int) {
@@ -3,6 +3,7 @@ package list
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -109,6 +110,120 @@ func TestListPageDown(t *testing.T) {
 	})
 }
 
+func TestListIndices(t *testing.T) {
+	letters := []rune{'a', 'b', 'c', 'd', 'e', 'f'}
+	l, err := New(letters, 3)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	l.PageDown()
+
+	got := l.Indices()
+	expected := []int{3, 4, 5}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected indices %v, got %v", expected, got)
+	}
+
+	l.Searcher = func(input string, index int) bool {
+		return letters[index] == 'b' || letters[index] == 'e'
+	}
+	l.Search("x")
+
+	got = l.Indices()
+	expected = []int{1, 4}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected indices %v, got %v", expected, got)
+	}
+}
+
+func TestListScorer(t *testing.T) {
+	names := []string{"billing", "api-gateway", "gateway", "users"}
+
+	l, err := New(names, 2)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	l.Ranker = Fuzzy(func(index int) string {
+		return names[index]
+	})
+	l.Search("gw")
+
+	items, _ := l.Items()
+	if !reflect.DeepEqual([]interface{}{"gateway", "api-gateway"}, items) {
+		t.Errorf("expected items sorted by score, got %v", items)
+	}
+
+	expected := [][]Span{{{0, 1}, {4, 5}}, {{4, 5}, {8, 9}}}
+	if got := l.Matches(); !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected matches %v, got %v", expected, got)
+	}
+
+	if idx := l.Index(); idx != 2 {
+		t.Errorf("expected index 2, got %d", idx)
+	}
+
+	l.CancelSearch()
+
+	if got := l.Matches(); got != nil {
+		t.Errorf("expected no matches after canceling the search, got %v", got)
+	}
+}
+
+// popularity ranks the items containing the searched term by a number of uses.
+type popularity struct {
+	names []string
+	uses  []int
+}
+
+func (p popularity) Rank(input string, index int) (int, []Span, bool) {
+	i := strings.Index(p.names[index], input)
+	if i < 0 {
+		return 0, nil, false
+	}
+	return p.uses[index], []Span{{Start: i, End: i + len(input)}}, true
+}
+
+func TestListRanker(t *testing.T) {
+	names := []string{"deploy", "deploy-staging", "logs", "deploy-prod"}
+
+	l, err := New(names, 4)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	l.Ranker = popularity{names: names, uses: []int{3, 1, 10, 7}}
+	l.Search("deploy")
+
+	items, _ := l.Items()
+	if !reflect.DeepEqual([]interface{}{"deploy-prod", "deploy", "deploy-staging"}, items) {
+		t.Errorf("expected items sorted by uses, got %v", items)
+	}
+
+	expected := []int{3, 0, 1}
+	if got := l.Indices(); !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected indices %v, got %v", expected, got)
+	}
+
+	l.Next()
+	if idx := l.Index(); idx != 0 {
+		t.Errorf("expected index 0, got %d", idx)
+	}
+}
+
+func TestSpans(t *testing.T) {
+	got := Spans([]int{0, 1, 2, 5, 7, 8})
+	expected := []Span{{0, 3}, {5, 6}, {7, 9}}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected spans %v, got %v", expected, got)
+	}
+
+	if got := Spans(nil); got != nil {
+		t.Errorf("expected no spans, got %v", got)
+	}
+}
+
 func TestListComparion(t *testing.T) {
 	t.Run("when item supports comparison", func(t *testing.T) {
 		type comparable struct {
@@ -162,3 +277,76 @@ func castList(list []interface{}) []rune {
 	}
 	return result
 }
+
+func TestListAppend(t *testing.T) {
+	l, err := New([]string{"one", "two"}, 3)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	l.Next()
+	l.Append("three", "twenty")
+
+	items, idx := l.Items()
+	if !reflect.DeepEqual([]interface{}{"one", "two", "three"}, items) || idx != 1 {
+		t.Errorf("expected items [one two three] with two active, got %v %d", items, idx)
+	}
+
+	l.Searcher = func(input string, index int) bool {
+		return strings.HasPrefix(fmt.Sprint(*l.items[index]), input)
+	}
+	l.Search("tw")
+	l.Next()
+
+	l.Append("twelve", "ten")
+
+	items, idx = l.Items()
+	if !reflect.DeepEqual([]interface{}{"two", "twenty", "twelve"}, items) || idx != 1 {
+		t.Errorf("expected searched items [two twenty twelve] with twenty active, got %v %d", items, idx)
+	}
+
+	if got := l.Index(); got != 3 {
+		t.Errorf("expected index 3, got %d", got)
+	}
+
+	if got := l.Len(); got != 6 {
+		t.Errorf("expected 6 items, got %d", got)
+	}
+}
+
+func TestListEmpty(t *testing.T) {
+	l, err := New([]string{}, 3)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if idx := l.Index(); idx != NotFound {
+		t.Errorf("expected index %d, got %d", NotFound, idx)
+	}
+}
+
+func TestListReplace(t *testing.T) {
+	l, err := New([]string{"one", "two", "three"}, 3)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	l.Searcher = func(input string, index int) bool {
+		return true
+	}
+	l.Search("t")
+	l.Next()
+
+	l.Replace("four", "five")
+
+	items, idx := l.Items()
+	if !reflect.DeepEqual([]interface{}{"four", "five"}, items) || idx != 0 {
+		t.Errorf("expected items [four five] with four active, got %v %d", items, idx)
+	}
+
+	l.Replace()
+
+	if _, idx := l.Items(); idx != NotFound {
+		t.Errorf("expected no active item, got %d", idx)
+	}
+}
@@ -0,0 +1,43 @@
+package list
+
+// Ranker ranks the items of a list against a searched term. Rank is called on each items of the list with the
+// searched term and the item's index and returns whether or not the item fits the term, a score used to sort
+// the fitting items from the highest to the lowest, and the spans of the item's text that match the term.
+//
+// Items with the same score keep their original order, so a ranker returning the same score for every item
+// filters the list without sorting it. Searcher and Scorer functions implement Ranker.
+type Ranker interface {
+	Rank(input string, index int) (score int, spans []Span, ok bool)
+}
+
+// Span is a range of runes of an item's text matching a searched term, from Start included to End excluded.
+type Span struct {
+	Start int
+	End   int
+}
+
+// Rank implements Ranker, giving the same score to all the items fitting the term so their order is kept.
+func (s Searcher) Rank(input string, index int) (int, []Span, bool) {
+	return 0, nil, s(input, index)
+}
+
+// Rank implements Ranker, turning the matched positions returned by the scorer into spans.
+func (s Scorer) Rank(input string, index int) (int, []Span, bool) {
+	score, positions, ok := s(input, index)
+	return score, Spans(positions), ok
+}
+
+// Spans groups consecutive positions of runes into spans. The positions must be sorted in increasing order.
+func Spans(positions []int) []Span {
+	var spans []Span
+
+	for _, p := range positions {
+		if n := len(spans); n > 0 && spans[n-1].End == p {
+			spans[n-1].End++
+			continue
+		}
+		spans = append(spans, Span{Start: p, End: p + 1})
+	}
+
+	return spans
+}
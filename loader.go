@@ -0,0 +1,100 @@
+package promptui
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/manifoldco/promptui/list"
+)
+
+// loadInterval is the interval at which a select is redrawn while its items are loading.
+const loadInterval = 100 * time.Millisecond
+
+// loader appends the items of a select received from a channel to its list while the select is displayed.
+type loader struct {
+	items   reflect.Value
+	loading bool
+	count   int
+	frame   int
+}
+
+// newLoader returns a loader for items if they are a channel, or nil otherwise.
+func newLoader(items interface{}) *loader {
+	v := reflect.ValueOf(items)
+	if v.Kind() != reflect.Chan {
+		return nil
+	}
+
+	return &loader{items: v, loading: true}
+}
+
+// start receives the items in the background and appends them to l until the channel is closed or ctx is done,
+// wg tracking the background goroutine. The list and the loader state are guarded by mu, which is held when
+// calling draw to redraw the select with the new items and spinner frame.
+func (ld *loader) start(ctx context.Context, wg *sync.WaitGroup, l *list.List, mu *sync.Mutex, draw func()) {
+	ticker := time.NewTicker(loadInterval)
+
+	cases := []reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: ld.items},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ticker.C)},
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer ticker.Stop()
+
+		for {
+			chosen, v, ok := reflect.Select(cases)
+			if chosen == 1 {
+				return
+			}
+
+			mu.Lock()
+
+			// ctx may be done while waiting for the lock, in which case the loader must not touch the list.
+			if ctx.Err() != nil {
+				mu.Unlock()
+				return
+			}
+
+			switch chosen {
+			case 0:
+				if !ok {
+					ld.loading = false
+					draw()
+					mu.Unlock()
+					return
+				}
+				l.Append(v.Interface())
+				ld.count++
+			case 2:
+				ld.frame++
+				draw()
+			}
+
+			mu.Unlock()
+		}
+	}()
+}
+
+// spinner returns the current frame of the spinner.
+func (ld *loader) spinner(frames []string) string {
+	return frames[ld.frame%len(frames)]
+}
+
+// receiveAll receives all the items of a channel, for when they cannot be loaded while the select is displayed.
+func receiveAll(items reflect.Value) reflect.Value {
+	values := reflect.MakeSlice(reflect.SliceOf(items.Type().Elem()), 0, 0)
+
+	for {
+		v, ok := items.Recv()
+		if !ok {
+			return values
+		}
+		values = reflect.Append(values, v)
+	}
+}
@@ -0,0 +1,121 @@
+package promptui
+
+import (
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/manifoldco/promptui/promptuitest"
+)
+
+// waitFor polls cond until it is true, failing the test after a second.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestSelectStream(t *testing.T) {
+	t.Run("appends items while the select is displayed", func(t *testing.T) {
+		items := make(chan string)
+		stdin, w := io.Pipe()
+		defer w.Close()
+
+		term := promptuitest.New()
+
+		// received is appended before sending each item, so the searcher sees every item it is called with.
+		var mu sync.Mutex
+		var received []string
+
+		s := Select{
+			Label: "Service",
+			Items: items,
+			Searcher: func(input string, index int) bool {
+				mu.Lock()
+				defer mu.Unlock()
+				return strings.Contains(received[index], input)
+			},
+			Stdin:  stdin,
+			Stdout: term.Stdout(),
+		}
+
+		type result struct {
+			idx   int
+			value string
+			err   error
+		}
+		done := make(chan result)
+		go func() {
+			idx, value, err := s.Run()
+			done <- result{idx, value, err}
+		}()
+
+		w.Write([]byte("/"))
+		w.Write([]byte("b"))
+
+		for _, item := range []string{"abc", "xyz", "b2"} {
+			mu.Lock()
+			received = append(received, item)
+			mu.Unlock()
+			items <- item
+		}
+
+		screen := func(text string) func() bool {
+			return func() bool {
+				return strings.Contains(term.Screen(), text)
+			}
+		}
+
+		waitFor(t, "the searched items", screen("b2"))
+		waitFor(t, "the loading indicator", screen("Loading 3 items"))
+
+		if strings.Contains(term.Screen(), "xyz") {
+			t.Errorf("expected the search to apply to new items, got\n%s", term.Screen())
+		}
+
+		close(items)
+		waitFor(t, "the end of loading", func() bool {
+			return !strings.Contains(term.Screen(), "Loading")
+		})
+
+		w.Write([]byte(promptuitest.Down))
+		w.Write([]byte(promptuitest.Enter))
+
+		r := <-done
+		if r.err != nil {
+			t.Fatalf("Unexpected error %v", r.err)
+		}
+
+		if r.idx != 2 || r.value != "b2" {
+			t.Errorf("expected 2 %q, got %d %q", "b2", r.idx, r.value)
+		}
+	})
+
+	t.Run("receives all items in line mode", func(t *testing.T) {
+		items := make(chan string, 3)
+		items <- "Zero"
+		items <- "One"
+		items <- "Two"
+		close(items)
+
+		stdin := pipeInput(t, "3\n")
+		defer stdin.Close()
+
+		s := Select{Label: "Number", Items: items, Stdin: stdin, Stdout: &nopWriteCloser{}}
+
+		idx, value, err := s.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if idx != 2 || value != "Two" {
+			t.Errorf("expected 2 %q, got %d %q", "Two", idx, value)
+		}
+	})
+}
@@ -0,0 +1,13 @@
+package promptui
+
+import (
+	"os"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	// DefaultColorProfile is global to all the tests of the package. The fake terminals of promptuitest are not
+	// detected as terminals, so prompts would strip their styles, which most tests expect to find in the output.
+	DefaultColorProfile = TrueColor
+	os.Exit(m.Run())
+}
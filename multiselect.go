@@ -0,0 +1,278 @@
+package promptui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+
+	"github.com/manifoldco/promptui/list"
+)
+
+// MultiSelect represents a list of items from which any number of items can be chosen. It shares its
+// navigation, search mode and templates with Select, with the addition of the Toggle key used to check or
+// uncheck the active item.
+type MultiSelect struct {
+	// Label is the text displayed on top of the list to direct input. The IconInitial value "?" will be
+	// appended automatically to the label so it does not need to be added.
+	//
+	// The value for Label can be a simple string or a struct that will need to be accessed by dot notation
+	// inside the templates. For example, `{{ .Name }}` will display the name property of a struct.
+	Label interface{}
+
+	// ID identifies the multi select when looking up preset answers. When the Answers provider has an answer for the ID,
+	// Run returns it without asking the user. See the AnswerProvider docs for more info.
+	ID string
+
+	// Items are the items to display inside the list. It expect a slice of any kind of values, including strings.
+	// See the Select docs for more info on how items are displayed.
+	Items interface{}
+
+	// Checked are the indices of the items that are checked when the list is first displayed.
+	Checked []int
+
+	// Min is the minimum number of items that must be checked before the selection can be submitted.
+	// Defaults to 0.
+	Min int
+
+	// Max is the maximum number of items that can be checked at once. Defaults to 0, which means
+	// there is no limit.
+	Max int
+
+	// Size is the number of items that should appear on the select before scrolling is necessary. Defaults to 5.
+	Size int
+
+	// Overflow sets how items wider than the terminal are displayed, either wrapped on several lines, the
+	// default, or truncated with an ellipsis.
+	Overflow Overflow
+
+	// CursorPos is the initial position of the cursor.
+	CursorPos int
+
+	// IsVimMode sets whether to use vim mode when using readline in the command prompt. Look at
+	// https://godoc.org/github.com/chzyer/readline#Config for more information on readline.
+	IsVimMode bool
+
+	// HideHelp sets whether to hide help information.
+	HideHelp bool
+
+	// HideSelected sets whether to hide the text displayed after the items are successfully selected.
+	HideSelected bool
+
+	// Templates can be used to customize the select output. If nil is passed, the default templates are used.
+	// The templates are the same as Select's, except for the Selected template which receives the slice of
+	// checked items instead of a single item. See the SelectTemplates docs for more info.
+	Templates *SelectTemplates
+
+	// Theme sets the icons, styles and default templates of the multi select. It defaults to DefaultTheme. See the
+	// Theme docs for more info.
+	Theme *Theme
+
+	// ColorProfile limits the styles displayed by the multi select, the ones beyond it being downgraded or stripped. It
+	// defaults to DefaultColorProfile, or to the profile detected from Stdout. Setting it overrides both, such as
+	// NoColor rendering plain text in tests or TrueColor keeping the styles when the output is piped.
+	ColorProfile ColorProfile
+
+	// Accessible runs the multi select in accessible mode, appending plain text lines suited to screen readers instead of
+	// redrawing its output. It is enabled for all of them by the Accessible variable.
+	Accessible bool
+
+	// Keys is the set of keys used in select mode to control the command line interface. See the SelectKeys docs for
+	// more info.
+	Keys *SelectKeys
+
+	// Searcher is a function that can be implemented to refine the base searching algorithm in selects.
+	// See the Select docs for more info.
+	Searcher list.Searcher
+
+	// Ranker can be implemented instead of Searcher to rank the items matching the searched term. See the Select
+	// docs for more info.
+	Ranker list.Ranker
+
+	// StartInSearchMode sets whether or not the select mode should start in search mode or selection mode.
+	// For search mode to work, the Search property must be implemented.
+	StartInSearchMode bool
+
+	// A function that determines how to render the cursor
+	Pointer Pointer
+
+	Stdin  io.ReadCloser
+	Stdout io.WriteCloser
+}
+
+// Run executes the multi select list. It displays the label and the list of items, letting the user check
+// any number of them with the Toggle key and submit the selection with enter. Run will keep the prompt alive
+// until it has been canceled from the command prompt or it has received a valid selection.
+//
+// It returns the indices of the checked items in the order they appear inside Items along with their string
+// values, and an error if any occurred during the select's execution.
+func (ms *MultiSelect) Run() ([]int, []string, error) {
+	return ms.RunContext(context.Background())
+}
+
+// RunContext executes the multi select list like Run, but stops it as soon as the given context is done. When
+// that happens, the list is cleared from the terminal and the context's error is returned.
+func (ms *MultiSelect) RunContext(ctx context.Context) ([]int, []string, error) {
+	if ms.Max > 0 && ms.Max < ms.Min {
+		return nil, nil, fmt.Errorf("max %d must be greater than or equal to min %d", ms.Max, ms.Min)
+	}
+
+	size := ms.Size
+	if size == 0 {
+		size = 5
+	}
+
+	l, err := list.New(ms.Items, size)
+	if err != nil {
+		return nil, nil, err
+	}
+	l.Searcher = ms.Searcher
+	l.Ranker = ms.Ranker
+
+	state := &multiState{
+		items:   reflect.ValueOf(ms.Items),
+		checked: make(map[int]bool),
+		min:     ms.Min,
+		max:     ms.Max,
+	}
+
+	for _, i := range ms.Checked {
+		if i < 0 || i >= state.items.Len() {
+			return nil, nil, fmt.Errorf("checked index %d is out of range", i)
+		}
+		state.checked[i] = true
+	}
+
+	if ms.Max > 0 && len(state.checked) > ms.Max {
+		return nil, nil, fmt.Errorf("%d items are checked but max is %d", len(state.checked), ms.Max)
+	}
+
+	s := Select{
+		Label:             ms.Label,
+		ID:                ms.ID,
+		Items:             ms.Items,
+		Size:              size,
+		Overflow:          ms.Overflow,
+		IsVimMode:         ms.IsVimMode,
+		HideHelp:          ms.HideHelp,
+		HideSelected:      ms.HideSelected,
+		Templates:         ms.Templates,
+		Theme:             ms.Theme,
+		ColorProfile:      ms.ColorProfile,
+		Accessible:        ms.Accessible,
+		Keys:              ms.Keys,
+		Searcher:          ms.Searcher,
+		Ranker:            ms.Ranker,
+		StartInSearchMode: ms.StartInSearchMode,
+		Pointer:           ms.Pointer,
+		Stdin:             ms.Stdin,
+		Stdout:            ms.Stdout,
+		list:              l,
+		multi:             state,
+	}
+
+	s.setKeys()
+	if s.Keys.Toggle.Code == 0 {
+		s.Keys.Toggle = Key{Code: ' ', Display: "space"}
+	}
+
+	err = ms.prepareTemplates(&s)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ms.Templates = s.Templates
+	ms.Keys = s.Keys
+
+	_, _, err = s.innerRun(ctx, ms.CursorPos, 0, ' ')
+	if err != nil {
+		return nil, nil, err
+	}
+
+	indices := state.indices()
+	values := make([]string, len(indices))
+	for i, idx := range indices {
+		values[i] = fmt.Sprintf("%v", state.items.Index(idx).Interface())
+	}
+
+	return indices, values, nil
+}
+
+func (ms *MultiSelect) prepareTemplates(s *Select) error {
+	tpls := s.Templates
+	if tpls == nil {
+		tpls = &SelectTemplates{}
+	}
+
+	th := resolveTheme(s.Theme)
+
+	if tpls.Selected == "" {
+		tpls.Selected = th.MultiSelect.Selected
+	}
+
+	if tpls.Help == "" {
+		tpls.Help = th.MultiSelect.Help
+	}
+
+	s.Templates = tpls
+
+	return s.prepareTemplates()
+}
+
+// multiState tracks the checked items of a MultiSelect while it is running through the select loop.
+type multiState struct {
+	items   reflect.Value
+	checked map[int]bool
+	min     int
+	max     int
+
+	// err is the message displayed below the list until the next key press.
+	err error
+}
+
+func (m *multiState) toggle(i int) {
+	if m.checked[i] {
+		delete(m.checked, i)
+		return
+	}
+
+	if m.max > 0 && len(m.checked) >= m.max {
+		m.err = fmt.Errorf("select at most %d items", m.max)
+		return
+	}
+
+	m.checked[i] = true
+}
+
+func (m *multiState) mark(i int, icons Icons) string {
+	if m.checked[i] {
+		return icons.Checked + " "
+	}
+	return icons.Unchecked + " "
+}
+
+func (m *multiState) validate() error {
+	if len(m.checked) < m.min {
+		return fmt.Errorf("select at least %d items", m.min)
+	}
+	return nil
+}
+
+func (m *multiState) indices() []int {
+	indices := make([]int, 0, len(m.checked))
+	for i := range m.checked {
+		indices = append(indices, i)
+	}
+	sort.Ints(indices)
+	return indices
+}
+
+func (m *multiState) values() []interface{} {
+	indices := m.indices()
+	values := make([]interface{}, len(indices))
+	for i, idx := range indices {
+		values[i] = m.items.Index(idx).Interface()
+	}
+	return values
+}
@@ -0,0 +1,137 @@
+package promptui
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/manifoldco/promptui/promptuitest"
+)
+
+func TestMultiSelectState(t *testing.T) {
+	newState := func(min, max int) *multiState {
+		return &multiState{
+			items:   reflect.ValueOf([]string{"a", "b", "c"}),
+			checked: make(map[int]bool),
+			min:     min,
+			max:     max,
+		}
+	}
+
+	t.Run("toggles items on and off", func(t *testing.T) {
+		m := newState(0, 0)
+		m.toggle(2)
+		m.toggle(0)
+		m.toggle(1)
+		m.toggle(1)
+
+		exp := []int{0, 2}
+		if got := m.indices(); !reflect.DeepEqual(exp, got) {
+			t.Errorf("expected indices %v, got %v", exp, got)
+		}
+
+		values := m.values()
+		if !reflect.DeepEqual([]interface{}{"a", "c"}, values) {
+			t.Errorf("expected values [a c], got %v", values)
+		}
+	})
+
+	t.Run("refuses to check more than max", func(t *testing.T) {
+		m := newState(0, 1)
+		m.toggle(0)
+		m.toggle(1)
+
+		if m.err == nil {
+			t.Errorf("expected an error when checking more than max")
+		}
+
+		if len(m.checked) != 1 {
+			t.Errorf("expected 1 checked item, got %d", len(m.checked))
+		}
+	})
+
+	t.Run("validates min", func(t *testing.T) {
+		m := newState(2, 0)
+		m.toggle(0)
+
+		if err := m.validate(); err == nil {
+			t.Errorf("expected an error with fewer checked items than min")
+		}
+
+		m.toggle(1)
+
+		if err := m.validate(); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("marks checked items", func(t *testing.T) {
+		m := newState(0, 0)
+		m.toggle(1)
+
+		icons := Icons{}.styled(IconStyles{})
+
+		if got := m.mark(1, icons); got != IconChecked+" " {
+			t.Errorf("expected checked mark, got %q", got)
+		}
+
+		if got := m.mark(0, icons); got != IconUnchecked+" " {
+			t.Errorf("expected unchecked mark, got %q", got)
+		}
+	})
+}
+
+func TestMultiSelectTemplateRender(t *testing.T) {
+	ms := MultiSelect{
+		Label: "Select Numbers",
+		Items: []string{"Zero", "One"},
+	}
+
+	s := Select{Label: ms.Label, Items: ms.Items}
+	s.setKeys()
+
+	err := ms.prepareTemplates(&s)
+	if err != nil {
+		t.Fatalf("Unexpected error preparing templates %v", err)
+	}
+
+	result := string(render(s.Templates.selected, []interface{}{"Zero", "One"}))
+	exp := "\x1b[32m\x1b[32m✔\x1b[0m \x1b[2mZero\x1b[0m\x1b[2m, \x1b[0m\x1b[2mOne\x1b[0m"
+	if result != exp {
+		t.Errorf("Expected selected items to eq %q, got %q", exp, result)
+	}
+}
+
+func TestMultiSelectRun(t *testing.T) {
+	cities := []string{"Boston", "New York", "Newark"}
+
+	term := promptuitest.New()
+	term.Type(promptuitest.Space, "/", "new york", promptuitest.Enter)
+
+	ms := MultiSelect{
+		Label: "Cities",
+		Items: cities,
+		Searcher: func(input string, index int) bool {
+			return strings.Contains(strings.ToLower(cities[index]), input)
+		},
+		Stdin:  term.Stdin(),
+		Stdout: term.Stdout(),
+	}
+
+	indices, values, err := ms.Run()
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if exp := []int{0}; !reflect.DeepEqual(exp, indices) {
+		t.Errorf("expected indices %v, got %v", exp, indices)
+	}
+
+	if exp := []string{"Boston"}; !reflect.DeepEqual(exp, values) {
+		t.Errorf("expected values %v, got %v", exp, values)
+	}
+
+	if !strings.Contains(term.Output(), SearchPrompt+"new york") {
+		t.Errorf("expected the search term to hold a space in %q", term.Output())
+	}
+}
@@ -1,10 +1,13 @@
 package promptui
 
 import (
-	"fmt"
+	"context"
 	"io"
 	"strings"
+	"sync"
 	"text/template"
+	"time"
+	"unicode"
 
 	"github.com/chzyer/readline"
 	"github.com/manifoldco/promptui/screenbuf"
@@ -18,6 +21,10 @@ type Prompt struct {
 	// inside the templates. For example, `{{ .Name }}` will display the name property of a struct.
 	Label interface{}
 
+	// ID identifies the prompt when looking up preset answers. When the Answers provider has an answer for the ID,
+	// Run returns it without asking the user. See the AnswerProvider docs for more info.
+	ID string
+
 	// Default is the initial value for the prompt. This value will be displayed next to the prompt's label
 	// and the user will be able to view or change it depending on the options.
 	Default string
@@ -29,6 +36,20 @@ type Prompt struct {
 	// Validate is an optional function that fill be used against the entered value in the prompt to validate it.
 	Validate ValidateFunc
 
+	// ValidateAsync is an optional function validating the entered value in the background, for checks too slow
+	// to run on each key press such as calls to a service. It runs once Validate passes and ValidateDelay has passed
+	// since the last key press, the label using the Pending template meanwhile. The value can only be submitted
+	// once the check of its latest version has passed.
+	ValidateAsync AsyncValidateFunc
+
+	// ValidateDelay is the time to wait after the last key press before running ValidateAsync. It defaults to
+	// 300ms.
+	ValidateDelay time.Duration
+
+	// ConfirmWarnings makes the user press enter a second time to submit a value for which a ValidationWarning
+	// is returned by Validate or ValidateAsync. By default, such values are submitted right away.
+	ConfirmWarnings bool
+
 	// Mask is an optional rune that sets which character to display instead of the entered characters. This
 	// allows hiding private information like passwords.
 	Mask rune
@@ -40,6 +61,19 @@ type Prompt struct {
 	// default templates are used. See the PromptTemplates docs for more info.
 	Templates *PromptTemplates
 
+	// Theme sets the icons, styles and default templates of the prompt. It defaults to DefaultTheme. See the Theme
+	// docs for more info.
+	Theme *Theme
+
+	// ColorProfile limits the styles displayed by the prompt, the ones beyond it being downgraded or stripped. It
+	// defaults to DefaultColorProfile, or to the profile detected from Stdout. Setting it overrides both, such as
+	// NoColor rendering plain text in tests or TrueColor keeping the styles when the output is piped.
+	ColorProfile ColorProfile
+
+	// Accessible runs the prompt in accessible mode, appending plain text lines suited to screen readers instead of
+	// redrawing its output. It is enabled for all of them by the Accessible variable.
+	Accessible bool
+
 	// IsConfirm makes the prompt ask for a yes or no ([Y/N]) question rather than request an input. When set,
 	// most properties related to input will be ignored.
 	IsConfirm bool
@@ -47,11 +81,24 @@ type Prompt struct {
 	// IsVimMode enables vi-like movements (hjkl) and editing.
 	IsVimMode bool
 
+	// Suggest is an optional function returning the candidates to complete the current input with. They are
+	// displayed below the prompt, where KeyNext and KeyPrev move between them and KeyTab replaces the input with
+	// the selected one. The rest of the selected candidate is shown after the input when it starts with it.
+	Suggest func(input string) []string
+
+	// History is an optional history recording the answers of the prompt under its ID. The previous answers
+	// can be recalled with KeyPrev and KeyNext and searched with KeyHistorySearch. See the History docs for more
+	// info.
+	History *History
+
 	// the Pointer defines how to render the cursor.
 	Pointer Pointer
 
 	Stdin  io.ReadCloser
 	Stdout io.WriteCloser
+
+	// step is set by typed prompts to change the value entered by n steps with KeyPrev and KeyNext.
+	step func(input string, n int) (string, bool)
 }
 
 // PromptTemplates allow a prompt to be customized following stdlib
@@ -86,6 +133,14 @@ type PromptTemplates struct {
 	// Invalid is a text/template for the prompt label when the value entered is invalid.
 	Invalid string
 
+	// Pending is a text/template for the prompt label while the value entered is being checked by the prompt's
+	// ValidateAsync function.
+	Pending string
+
+	// Warning is a text/template for the message of a ValidationWarning returned by the prompt's validation
+	// functions, displayed below the input.
+	Warning string
+
 	// Success is a text/template for the prompt label when the user has pressed entered and the value has been
 	// deemed valid by the validation function. The label will keep using this template even when the prompt ends
 	// inside the console.
@@ -95,6 +150,19 @@ type PromptTemplates struct {
 	// the prompt's validation function.
 	ValidationError string
 
+	// Suggestion is a text/template for the candidates suggested by the prompt's Suggest function.
+	Suggestion string
+
+	// ActiveSuggestion is a text/template for the suggested candidate currently selected.
+	ActiveSuggestion string
+
+	// Ghost is a text/template for the rest of the selected candidate, displayed after the input.
+	Ghost string
+
+	// HistorySearch is a text/template for the line displayed below the prompt while searching its history. The
+	// Term field holds the searched term and Failed whether no entry contains it.
+	HistorySearch string
+
 	// FuncMap is a map of helper functions that can be used inside of templates according to the text/template
 	// documentation.
 	//
@@ -102,17 +170,29 @@ type PromptTemplates struct {
 	// is overridden, the colors functions must be added in the override from promptui.FuncMap to work.
 	FuncMap template.FuncMap
 
-	prompt     *template.Template
-	valid      *template.Template
-	invalid    *template.Template
-	validation *template.Template
-	success    *template.Template
+	prompt           *template.Template
+	valid            *template.Template
+	invalid          *template.Template
+	pending          *template.Template
+	warning          *template.Template
+	validation       *template.Template
+	success          *template.Template
+	suggestion       *template.Template
+	activeSuggestion *template.Template
+	ghost            *template.Template
+	historySearch    *template.Template
 }
 
 // Run executes the prompt. Its displays the label and default value if any, asking the user to enter a value.
 // Run will keep the prompt alive until it has been canceled from the command prompt or it has received a valid
 // value. It will return the value and an error if any occurred during the prompt's execution.
 func (p *Prompt) Run() (string, error) {
+	return p.RunContext(context.Background())
+}
+
+// RunContext executes the prompt like Run, but stops it as soon as the given context is done. When that happens,
+// the prompt is cleared from the terminal and the context's error is returned.
+func (p *Prompt) RunContext(ctx context.Context) (string, error) {
 	var err error
 
 	err = p.prepareTemplates()
@@ -120,6 +200,18 @@ func (p *Prompt) Run() (string, error) {
 		return "", err
 	}
 
+	if answer, ok := presetAnswer(p.ID); ok {
+		return p.answer(ctx, answer)
+	}
+
+	if p.Accessible || Accessible {
+		return p.runAccessible(ctx)
+	}
+
+	if !isInteractive(p.Stdin) {
+		return p.runLine(ctx)
+	}
+
 	c := &readline.Config{
 		Stdin:          p.Stdin,
 		Stdout:         p.Stdout,
@@ -130,19 +222,6 @@ func (p *Prompt) Run() (string, error) {
 		UniqueEditLine: true,
 	}
 
-	err = c.Init()
-	if err != nil {
-		return "", err
-	}
-
-	rl, err := readline.NewEx(c)
-	if err != nil {
-		return "", err
-	}
-	// we're taking over the cursor,  so stop showing it.
-	rl.Write([]byte(hideCursor))
-	sb := screenbuf.New(rl)
-
 	validFn := func(x string) error {
 		return nil
 	}
@@ -158,12 +237,118 @@ func (p *Prompt) Run() (string, error) {
 	eraseDefault := input != "" && !p.AllowEdit
 	cur := NewCursor(input, p.Pointer, eraseDefault)
 
-	listen := func(input []rune, pos int, key rune) ([]rune, int, bool) {
-		_, _, keepOn := cur.Listen(input, pos, key)
+	var sugg *suggester
+	if p.Suggest != nil && !p.IsConfirm {
+		sugg = &suggester{suggest: p.Suggest}
+	}
+
+	var rec *recall
+	if p.History != nil && p.Mask == 0 && !p.IsConfirm {
+		entries, err := p.History.Entries(p.ID)
+		if err != nil {
+			return "", err
+		}
+		rec = newRecall(entries)
+	}
+
+	// mu guards the input and the screen, which are also updated in the background by asynchronous validation.
+	var mu sync.Mutex
+	var draw func()
+
+	var chk *checker
+	if p.ValidateAsync != nil && !p.IsConfirm {
+		delay := p.ValidateDelay
+		if delay == 0 {
+			delay = defaultValidateDelay
+		}
+		chk = &checker{validate: p.ValidateAsync, delay: delay, mu: &mu}
+	}
+
+	// validate returns the validation error of the input, starting its asynchronous validation if needed.
+	validate := func() error {
 		err := validFn(cur.Get())
+		if err == nil && chk != nil {
+			err = chk.check(ctx, cur.Get())
+		}
+		return err
+	}
+
+	// Keys are filtered before readline handles them, so that tab accepts the selected suggestion instead of
+	// completing the line and the history search replaces the one of readline. Filtered keys never reach the
+	// listener, so the prompt is redrawn here.
+	c.FuncFilterInputRune = func(r rune) (rune, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case rec != nil && rec.searching:
+			switch {
+			case r == KeyHistorySearch:
+				rec.searchNext()
+			case r == KeyBackspace || r == KeyCtrlH:
+				rec.shorten()
+			case r == readline.CharBell:
+				rec.cancelSearch()
+			case unicode.IsPrint(r):
+				rec.extend(r)
+			default:
+				// any other key ends the search and is handled with the entry found.
+				rec.searching = false
+				draw()
+				return r, true
+			}
+			cur.Replace(rec.current())
+		case rec != nil && r == KeyHistorySearch:
+			rec.startSearch(cur.Get())
+		case sugg != nil && r == KeyTab:
+			candidate, ok := sugg.selected()
+			if !ok {
+				return r, true
+			}
+			cur.Replace(candidate)
+		default:
+			return r, true
+		}
+
+		cur.erase = false
+		if sugg != nil {
+			sugg.update(cur.Get())
+		}
+		draw()
+		return r, false
+	}
+
+	err = c.Init()
+	if err != nil {
+		return "", err
+	}
+
+	stdin := readline.NewCancelableStdin(c.Stdin)
+	c.Stdin = stdin
+
+	rl, err := readline.NewEx(c)
+	if err != nil {
+		return "", err
+	}
+	// we're taking over the cursor,  so stop showing it.
+	rl.Write([]byte(hideCursor))
+	sb := screenbuf.New(newProfileWriter(rl, p.ColorProfile, p.Stdout))
+
+	stop := cancelOnDone(ctx, stdin)
+	defer stop()
+
+	draw = func() {
+		err := validate()
 		var prompt []byte
 
-		if err != nil {
+		var warning error
+		if isWarning(err) {
+			warning, err = err, nil
+		}
+
+		if err == errValidating {
+			prompt = render(p.Templates.pending, p.Label)
+		} else if err != nil {
 			prompt = render(p.Templates.invalid, p.Label)
 		} else {
 			prompt = render(p.Templates.valid, p.Label)
@@ -178,23 +363,134 @@ func (p *Prompt) Run() (string, error) {
 		}
 
 		prompt = append(prompt, []byte(echo)...)
+
+		if sugg != nil && cur.Position == len(cur.input) {
+			if ghost := sugg.ghost(); ghost != "" {
+				prompt = append(prompt, render(p.Templates.ghost, ghost)...)
+			}
+		}
+
 		sb.Reset()
+		sb.SetWidth(terminalWidth(p.Stdout))
 		sb.Write(prompt)
+
+		if sugg != nil && sugg.list != nil {
+			items, idx := sugg.list.Items()
+			for i, item := range items {
+				if i == idx {
+					sb.Write(render(p.Templates.activeSuggestion, item))
+				} else {
+					sb.Write(render(p.Templates.suggestion, item))
+				}
+			}
+		}
+
+		if rec != nil && rec.searching {
+			sb.Write(render(p.Templates.historySearch, struct {
+				Term   string
+				Failed bool
+			}{rec.term, rec.failed}))
+		}
+
+		if warning != nil {
+			sb.Write(render(p.Templates.warning, warning))
+		}
+
 		if inputErr != nil {
 			validation := render(p.Templates.validation, inputErr)
 			sb.Write(validation)
 			inputErr = nil
 		}
 		sb.Flush()
+	}
+
+	if chk != nil {
+		chk.draw = draw
+	}
+
+	listen := func(input []rune, pos int, key rune) ([]rune, int, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		// readline returns the line before notifying the listener of enter, so the prompt is already being
+		// finalized and must not be redrawn.
+		if key == KeyEnter {
+			return nil, 0, true
+		}
+
+		if sugg != nil && sugg.list != nil && (key == KeyNext || key == KeyPrev) {
+			if key == KeyNext {
+				sugg.list.Next()
+			} else {
+				sugg.list.Prev()
+			}
+			draw()
+			return nil, 0, true
+		}
+
+		if p.step != nil && (key == KeyNext || key == KeyPrev) {
+			n := 1
+			if key == KeyNext {
+				n = -1
+			}
+			if input, ok := p.step(cur.Get(), n); ok {
+				cur.Replace(input)
+				cur.erase = false
+			}
+			draw()
+			return nil, 0, true
+		}
+
+		if rec != nil && (key == KeyNext || key == KeyPrev) {
+			var moved bool
+			if key == KeyNext {
+				moved = rec.next()
+			} else {
+				moved = rec.prev(cur.Get())
+			}
+			if moved {
+				cur.Replace(rec.current())
+				cur.erase = false
+			}
+			if sugg != nil {
+				sugg.update(cur.Get())
+			}
+			draw()
+			return nil, 0, true
+		}
+
+		_, _, keepOn := cur.Listen(input, pos, key)
+		if sugg != nil {
+			sugg.update(cur.Get())
+		}
+		draw()
 		return nil, 0, keepOn
 	}
 
 	c.SetListener(listen)
 
+	// warned holds the input last submitted with a warning, which is confirmed by submitting it again.
+	var warned *string
+
 	for {
 		_, err = rl.Readline()
-		inputErr = validFn(cur.Get())
-		if inputErr == nil {
+		if err == nil && wentBack(ctx) {
+			err = errBack
+			break
+		}
+
+		mu.Lock()
+		inputErr = validate()
+		confirm := false
+		if isWarning(inputErr) {
+			inputErr = nil
+			input := cur.Get()
+			confirm = p.ConfirmWarnings && (warned == nil || *warned != input)
+			warned = &input
+		}
+		mu.Unlock()
+
+		if inputErr == nil && !confirm {
 			break
 		}
 
@@ -203,6 +499,21 @@ func (p *Prompt) Run() (string, error) {
 		}
 	}
 
+	if chk != nil {
+		chk.stop()
+	}
+
+	if err != nil && ctx.Err() != nil {
+		err = ctx.Err()
+	}
+
+	if err == errBack || (err != nil && err == ctx.Err()) {
+		clearScreen(sb)
+		rl.Write([]byte(showCursor))
+		rl.Close()
+		return "", err
+	}
+
 	if err != nil {
 		switch err {
 		case readline.ErrInterrupt:
@@ -248,6 +559,10 @@ func (p *Prompt) Run() (string, error) {
 	rl.Write([]byte(showCursor))
 	rl.Close()
 
+	if err == nil && rec != nil {
+		err = p.History.Add(p.ID, cur.Get())
+	}
+
 	return cur.Get(), err
 }
 
@@ -261,7 +576,8 @@ func (p *Prompt) prepareTemplates() error {
 		tpls.FuncMap = FuncMap
 	}
 
-	bold := Styler(FGBold)
+	th := resolveTheme(p.Theme)
+	funcs := th.funcs(tpls.FuncMap)
 
 	if p.IsConfirm {
 		if tpls.Confirm == "" {
@@ -269,10 +585,10 @@ func (p *Prompt) prepareTemplates() error {
 			if strings.ToLower(p.Default) == "y" {
 				confirm = "Y/n"
 			}
-			tpls.Confirm = fmt.Sprintf(`{{ "%s" | bold }} {{ . | bold }}? {{ "[%s]" | faint }} `, IconInitial, confirm)
+			tpls.Confirm = th.confirm(confirm)
 		}
 
-		tpl, err := template.New("").Funcs(tpls.FuncMap).Parse(tpls.Confirm)
+		tpl, err := template.New("").Funcs(funcs).Parse(tpls.Confirm)
 		if err != nil {
 			return err
 		}
@@ -280,10 +596,10 @@ func (p *Prompt) prepareTemplates() error {
 		tpls.prompt = tpl
 	} else {
 		if tpls.Prompt == "" {
-			tpls.Prompt = fmt.Sprintf("%s {{ . | bold }}%s ", bold(IconInitial), bold(":"))
+			tpls.Prompt = th.Prompt.Prompt
 		}
 
-		tpl, err := template.New("").Funcs(tpls.FuncMap).Parse(tpls.Prompt)
+		tpl, err := template.New("").Funcs(funcs).Parse(tpls.Prompt)
 		if err != nil {
 			return err
 		}
@@ -292,10 +608,10 @@ func (p *Prompt) prepareTemplates() error {
 	}
 
 	if tpls.Valid == "" {
-		tpls.Valid = fmt.Sprintf("%s {{ . | bold }}%s ", bold(IconGood), bold(":"))
+		tpls.Valid = th.Prompt.Valid
 	}
 
-	tpl, err := template.New("").Funcs(tpls.FuncMap).Parse(tpls.Valid)
+	tpl, err := template.New("").Funcs(funcs).Parse(tpls.Valid)
 	if err != nil {
 		return err
 	}
@@ -303,38 +619,104 @@ func (p *Prompt) prepareTemplates() error {
 	tpls.valid = tpl
 
 	if tpls.Invalid == "" {
-		tpls.Invalid = fmt.Sprintf("%s {{ . | bold }}%s ", bold(IconBad), bold(":"))
+		tpls.Invalid = th.Prompt.Invalid
 	}
 
-	tpl, err = template.New("").Funcs(tpls.FuncMap).Parse(tpls.Invalid)
+	tpl, err = template.New("").Funcs(funcs).Parse(tpls.Invalid)
 	if err != nil {
 		return err
 	}
 
 	tpls.invalid = tpl
 
+	if tpls.Pending == "" {
+		tpls.Pending = th.Prompt.Pending
+	}
+
+	tpl, err = template.New("").Funcs(funcs).Parse(tpls.Pending)
+	if err != nil {
+		return err
+	}
+
+	tpls.pending = tpl
+
 	if tpls.ValidationError == "" {
-		tpls.ValidationError = `{{ ">>" | red }} {{ . | red }}`
+		tpls.ValidationError = th.Prompt.ValidationError
 	}
 
-	tpl, err = template.New("").Funcs(tpls.FuncMap).Parse(tpls.ValidationError)
+	tpl, err = template.New("").Funcs(funcs).Parse(tpls.ValidationError)
 	if err != nil {
 		return err
 	}
 
 	tpls.validation = tpl
 
+	if tpls.Warning == "" {
+		tpls.Warning = th.Prompt.Warning
+	}
+
+	tpl, err = template.New("").Funcs(funcs).Parse(tpls.Warning)
+	if err != nil {
+		return err
+	}
+
+	tpls.warning = tpl
+
 	if tpls.Success == "" {
-		tpls.Success = fmt.Sprintf("{{ . | faint }}%s ", Styler(FGFaint)(":"))
+		tpls.Success = th.Prompt.Success
 	}
 
-	tpl, err = template.New("").Funcs(tpls.FuncMap).Parse(tpls.Success)
+	tpl, err = template.New("").Funcs(funcs).Parse(tpls.Success)
 	if err != nil {
 		return err
 	}
 
 	tpls.success = tpl
 
+	if tpls.Suggestion == "" {
+		tpls.Suggestion = th.Prompt.Suggestion
+	}
+
+	tpl, err = template.New("").Funcs(funcs).Parse(tpls.Suggestion)
+	if err != nil {
+		return err
+	}
+
+	tpls.suggestion = tpl
+
+	if tpls.ActiveSuggestion == "" {
+		tpls.ActiveSuggestion = th.Prompt.ActiveSuggestion
+	}
+
+	tpl, err = template.New("").Funcs(funcs).Parse(tpls.ActiveSuggestion)
+	if err != nil {
+		return err
+	}
+
+	tpls.activeSuggestion = tpl
+
+	if tpls.Ghost == "" {
+		tpls.Ghost = th.Prompt.Ghost
+	}
+
+	tpl, err = template.New("").Funcs(funcs).Parse(tpls.Ghost)
+	if err != nil {
+		return err
+	}
+
+	tpls.ghost = tpl
+
+	if tpls.HistorySearch == "" {
+		tpls.HistorySearch = th.Prompt.HistorySearch
+	}
+
+	tpl, err = template.New("").Funcs(funcs).Parse(tpls.HistorySearch)
+	if err != nil {
+		return err
+	}
+
+	tpls.historySearch = tpl
+
 	p.Templates = tpls
 
 	return nil
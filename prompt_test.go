@@ -0,0 +1,114 @@
+package promptui
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/manifoldco/promptui/promptuitest"
+)
+
+// nopWriteCloser collects the output of a prompt during tests.
+type nopWriteCloser struct {
+	bytes.Buffer
+}
+
+func (w *nopWriteCloser) Close() error {
+	return nil
+}
+
+func TestPromptRunContext(t *testing.T) {
+	t.Run("when the context is canceled", func(t *testing.T) {
+		stdin, w := io.Pipe()
+		defer w.Close()
+
+		p := Prompt{
+			Label:  "Name",
+			Stdin:  stdin,
+			Stdout: &nopWriteCloser{},
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		_, err := p.RunContext(ctx)
+		if err != context.DeadlineExceeded {
+			t.Errorf("expected %v, got %v", context.DeadlineExceeded, err)
+		}
+	})
+}
+
+func TestPromptValidationWarning(t *testing.T) {
+	validate := func(input string) error {
+		if len(input) < 8 {
+			return Warnf("password is weak")
+		}
+		return nil
+	}
+
+	t.Run("submits the input with a warning", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type("1234", promptuitest.Enter)
+
+		p := Prompt{
+			Label:    "Password",
+			Validate: validate,
+			Stdin:    term.Stdin(),
+			Stdout:   term.Stdout(),
+		}
+
+		result, err := p.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if result != "1234" {
+			t.Errorf("expected %q, got %q", "1234", result)
+		}
+
+		if !strings.Contains(term.Output(), "password is weak") {
+			t.Errorf("expected the warning to be displayed, got %q", term.Output())
+		}
+	})
+
+	t.Run("confirms the input with a second enter", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type("1234", promptuitest.Enter, "5", promptuitest.Enter, promptuitest.Enter)
+
+		p := Prompt{
+			Label:           "Password",
+			Validate:        validate,
+			ConfirmWarnings: true,
+			Stdin:           term.Stdin(),
+			Stdout:          term.Stdout(),
+		}
+
+		result, err := p.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if result != "12345" {
+			t.Errorf("expected %q, got %q", "12345", result)
+		}
+	})
+
+	t.Run("accepts the input with a warning in line mode", func(t *testing.T) {
+		stdin := pipeInput(t, "1234\n")
+		defer stdin.Close()
+
+		p := Prompt{Label: "Password", Validate: validate, Stdin: stdin, Stdout: &nopWriteCloser{}}
+
+		result, err := p.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if result != "1234" {
+			t.Errorf("expected %q, got %q", "1234", result)
+		}
+	})
+}
@@ -10,7 +10,10 @@
 // detailed view and custom templates.
 package promptui
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // ErrEOF is the error returned from prompts when EOF is encountered.
 var ErrEOF = errors.New("^D")
@@ -22,6 +25,37 @@ var ErrInterrupt = errors.New("^C")
 // ErrAbort is the error returned when confirm prompts are supplied "n"
 var ErrAbort = errors.New("")
 
+// ErrNoInput is the error returned from prompts running without a terminal when there is no input left
+// to read from stdin.
+var ErrNoInput = errors.New("no input available")
+
 // ValidateFunc is a placeholder type for any validation functions that validates a given input. It should return
 // a ValidationError if the input is not valid.
 type ValidateFunc func(string) error
+
+// ValidationWarning is an error returned by validation functions to warn about an input which is accepted
+// nonetheless, such as a weak password. Prompts display its message with their Warning template below the input
+// and let the user submit the value, unless ConfirmWarnings is set in which case enter must be pressed twice.
+type ValidationWarning struct {
+	Err error
+}
+
+// Warnf returns a ValidationWarning with a message formatted like fmt.Sprintf.
+func Warnf(format string, a ...interface{}) error {
+	return &ValidationWarning{Err: fmt.Errorf(format, a...)}
+}
+
+func (w *ValidationWarning) Error() string {
+	return w.Err.Error()
+}
+
+// Unwrap returns the error warned about.
+func (w *ValidationWarning) Unwrap() error {
+	return w.Err
+}
+
+// isWarning returns whether err is a ValidationWarning.
+func isWarning(err error) bool {
+	_, ok := err.(*ValidationWarning)
+	return ok
+}
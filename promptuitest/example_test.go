@@ -0,0 +1,31 @@
+package promptuitest_test
+
+import (
+	"fmt"
+
+	"github.com/manifoldco/promptui"
+	"github.com/manifoldco/promptui/promptuitest"
+)
+
+// This example drives a select with scripted keystrokes and prints the screen once the item is chosen.
+func Example() {
+	term := promptuitest.New()
+	term.Type(promptuitest.Down, promptuitest.Enter)
+
+	s := promptui.Select{
+		Label:     "Select Day",
+		Items:     []string{"Monday", "Tuesday", "Wednesday"},
+		Templates: &promptui.SelectTemplates{Selected: "You chose {{ . }}"},
+		Stdin:     term.Stdin(),
+		Stdout:    term.Stdout(),
+	}
+
+	_, _, err := s.Run()
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Print(term.Screen())
+	// Output: You chose Tuesday
+}
@@ -0,0 +1,37 @@
+package promptuitest
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// UpdateEnv is the environment variable which, when set to a non empty value, makes Golden write the expected
+// files instead of comparing against them.
+const UpdateEnv = "PROMPTUITEST_UPDATE"
+
+// Golden compares got against the content of the golden file at path, failing the test if they differ. The
+// golden files are created or updated with got when the UpdateEnv environment variable is set.
+func Golden(tb testing.TB, path string, got string) {
+	tb.Helper()
+
+	if os.Getenv(UpdateEnv) != "" {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			tb.Fatalf("could not create golden file directory: %v", err)
+		}
+		if err := ioutil.WriteFile(path, []byte(got), 0644); err != nil {
+			tb.Fatalf("could not update golden file: %v", err)
+		}
+		return
+	}
+
+	exp, err := ioutil.ReadFile(path)
+	if err != nil {
+		tb.Fatalf("could not read golden file, run with %s=1 to create it: %v", UpdateEnv, err)
+	}
+
+	if string(exp) != got {
+		tb.Errorf("screen does not match %s\nexpected:\n%s\ngot:\n%s", path, exp, got)
+	}
+}
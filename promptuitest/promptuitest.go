@@ -0,0 +1,212 @@
+// Package promptuitest provides a fake terminal to test programs using promptui without a real one.
+//
+// A Terminal replays a script of keystrokes through its Stdin and records everything written to its Stdout on
+// a virtual screen, interpreting the escape sequences used by promptui to redraw its prompts. Once a prompt
+// is done, the screen can be inspected as plain text lines or compared against a golden file:
+//
+//	term := promptuitest.New()
+//	term.Type("Pepper", promptuitest.Enter)
+//
+//	p := promptui.Prompt{Label: "Name", Stdin: term.Stdin(), Stdout: term.Stdout()}
+//	result, err := p.Run()
+//
+//	promptuitest.Golden(t, "testdata/name.golden", term.Screen())
+package promptuitest
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	"github.com/manifoldco/promptui/screenbuf"
+)
+
+// These are the sequences sent by a terminal for the keys used by promptui. They can be given to Type along
+// with regular text.
+const (
+	Enter     = "\r"
+	Tab       = "\t"
+	Space     = " "
+	Backspace = "\x7f"
+	Escape    = "\x1b"
+	Up        = "\x1b[A"
+	Down      = "\x1b[B"
+	Right     = "\x1b[C"
+	Left      = "\x1b[D"
+	CtrlC     = "\x03"
+	CtrlD     = "\x04"
+	CtrlG     = "\x07"
+	CtrlR     = "\x12"
+	CtrlX     = "\x18"
+)
+
+// DefaultWidth is the number of columns of a Terminal created with New.
+const DefaultWidth = 80
+
+// Terminal is a fake terminal for prompts. Keystrokes are queued with Type and read one at a time from Stdin,
+// which reports io.EOF once the script is exhausted so a prompt waiting for more input fails instead of
+// blocking forever. The output written to Stdout is rendered on a virtual screen.
+//
+// A Terminal can be shared by several prompts run one after the other, as a real terminal would be.
+type Terminal struct {
+	mu     sync.Mutex
+	keys   []string
+	output bytes.Buffer
+	screen *screenbuf.Terminal
+}
+
+// New creates a Terminal with DefaultWidth columns.
+func New() *Terminal {
+	return NewWithWidth(DefaultWidth)
+}
+
+// NewWithWidth creates a Terminal with the given number of columns. Text written beyond the last column wraps
+// on the next line.
+func NewWithWidth(width int) *Terminal {
+	return &Terminal{screen: screenbuf.NewTerminal(width)}
+}
+
+// Type queues keystrokes to be read from Stdin. Each argument is split into single keystrokes: escape sequences
+// such as Up or Down count as a single key while regular text is typed one character at a time.
+func (t *Terminal) Type(keys ...string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, k := range keys {
+		t.keys = append(t.keys, splitKeys(k)...)
+	}
+}
+
+// Stdin returns the stream to use as a prompt's Stdin.
+func (t *Terminal) Stdin() io.ReadCloser {
+	return &stdin{t: t}
+}
+
+// Stdout returns the stream to use as a prompt's Stdout.
+func (t *Terminal) Stdout() io.WriteCloser {
+	return &stdout{t: t}
+}
+
+// Lines returns the text currently displayed on the screen, one string per line, without styles, trailing
+// spaces and trailing empty lines.
+func (t *Terminal) Lines() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.screen.Lines()
+}
+
+// Cells returns the characters currently displayed on the screen along with their styles. See the
+// screenbuf.Terminal docs for more info.
+func (t *Terminal) Cells() [][]screenbuf.Cell {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.screen.Cells()
+}
+
+// Screen returns the text currently displayed on the screen as a single string. See Lines.
+func (t *Terminal) Screen() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.screen.String()
+}
+
+// Output returns everything written to Stdout so far, including escape sequences.
+func (t *Terminal) Output() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.output.String()
+}
+
+// CursorHidden reports whether the terminal cursor is currently hidden.
+func (t *Terminal) CursorHidden() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.screen.CursorHidden()
+}
+
+// Pending returns the number of keystrokes which have not been read yet.
+func (t *Terminal) Pending() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return len(t.keys)
+}
+
+type stdin struct {
+	t *Terminal
+}
+
+// Read returns a single keystroke at a time so that readline never reads ahead of the prompt consuming it.
+func (s *stdin) Read(p []byte) (int, error) {
+	s.t.mu.Lock()
+	defer s.t.mu.Unlock()
+
+	if len(s.t.keys) == 0 {
+		return 0, io.EOF
+	}
+
+	key := s.t.keys[0]
+	if len(p) < len(key) {
+		return 0, io.ErrShortBuffer
+	}
+
+	s.t.keys = s.t.keys[1:]
+	return copy(p, key), nil
+}
+
+func (s *stdin) Close() error {
+	return nil
+}
+
+type stdout struct {
+	t *Terminal
+}
+
+func (s *stdout) Write(p []byte) (int, error) {
+	s.t.mu.Lock()
+	defer s.t.mu.Unlock()
+
+	s.t.output.Write(p)
+	s.t.screen.Write(p)
+	return len(p), nil
+}
+
+func (s *stdout) Close() error {
+	return nil
+}
+
+// Width returns the number of columns of the terminal, letting prompts account for the lines which wrap.
+func (s *stdout) Width() int {
+	return s.t.screen.Width()
+}
+
+// splitKeys splits s into single keystrokes, keeping escape sequences whole.
+func splitKeys(s string) []string {
+	var keys []string
+	runes := []rune(s)
+
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '\x1b' || i+1 >= len(runes) || runes[i+1] != '[' {
+			keys = append(keys, string(runes[i]))
+			continue
+		}
+
+		j := i + 2
+		for j < len(runes) && (runes[j] < 0x40 || runes[j] > 0x7e) {
+			j++
+		}
+		if j == len(runes) {
+			j--
+		}
+
+		keys = append(keys, string(runes[i:j+1]))
+		i = j
+	}
+
+	return keys
+}
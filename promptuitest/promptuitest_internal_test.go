@@ -0,0 +1,15 @@
+package promptuitest
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitKeys(t *testing.T) {
+	got := splitKeys("ab" + Up + "é" + Enter + "\x1b")
+	exp := []string{"a", "b", Up, "é", Enter, "\x1b"}
+
+	if !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected %q, got %q", exp, got)
+	}
+}
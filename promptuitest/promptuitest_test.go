@@ -0,0 +1,113 @@
+package promptuitest_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/manifoldco/promptui"
+	"github.com/manifoldco/promptui/promptuitest"
+)
+
+func TestPrompt(t *testing.T) {
+	term := promptuitest.New()
+	term.Type("Peper", promptuitest.Left, promptuitest.Left, "p", promptuitest.Enter)
+
+	p := promptui.Prompt{
+		Label:  "Name",
+		Stdin:  term.Stdin(),
+		Stdout: term.Stdout(),
+	}
+
+	result, err := p.Run()
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if result != "Pepper" {
+		t.Errorf("expected %q, got %q", "Pepper", result)
+	}
+
+	promptuitest.Golden(t, "testdata/prompt.golden", term.Screen())
+
+	if term.CursorHidden() {
+		t.Errorf("expected cursor to be shown after the prompt")
+	}
+}
+
+func TestSelect(t *testing.T) {
+	term := promptuitest.New()
+	term.Type(promptuitest.Down, promptuitest.Down, promptuitest.Up, promptuitest.Enter)
+
+	s := promptui.Select{
+		Label:  "Day",
+		Items:  []string{"Monday", "Tuesday", "Wednesday"},
+		Stdin:  term.Stdin(),
+		Stdout: term.Stdout(),
+	}
+
+	idx, result, err := s.Run()
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if idx != 1 || result != "Tuesday" {
+		t.Errorf("expected 1 %q, got %d %q", "Tuesday", idx, result)
+	}
+
+	promptuitest.Golden(t, "testdata/select.golden", term.Screen())
+}
+
+func TestSequence(t *testing.T) {
+	term := promptuitest.New()
+	term.Type("y", promptuitest.Enter, promptuitest.Down, promptuitest.Enter)
+
+	confirm := promptui.Prompt{
+		Label:     "Continue",
+		IsConfirm: true,
+		Stdin:     term.Stdin(),
+		Stdout:    term.Stdout(),
+	}
+
+	_, err := confirm.Run()
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	s := promptui.Select{
+		Label:    "Size",
+		Items:    []string{"Small", "Large"},
+		HideHelp: true,
+		Stdin:    term.Stdin(),
+		Stdout:   term.Stdout(),
+	}
+
+	_, result, err := s.Run()
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if result != "Large" {
+		t.Errorf("expected %q, got %q", "Large", result)
+	}
+
+	exp := []string{"Continue: y", "✔ Large"}
+	if got := term.Lines(); !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected %q, got %q", exp, got)
+	}
+}
+
+func TestEndOfScript(t *testing.T) {
+	term := promptuitest.New()
+	term.Type("abc")
+
+	p := promptui.Prompt{
+		Label:  "Name",
+		Stdin:  term.Stdin(),
+		Stdout: term.Stdout(),
+	}
+
+	_, err := p.Run()
+	if err != promptui.ErrEOF {
+		t.Errorf("expected %v, got %v", promptui.ErrEOF, err)
+	}
+}
@@ -0,0 +1,152 @@
+package promptui
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/manifoldco/promptui/list"
+)
+
+// defaultQueryDelay is the time a select waits after the last key press before running its Query.
+const defaultQueryDelay = 200 * time.Millisecond
+
+// QueryFunc returns the items of a select matching a searched term, either as a slice or as a channel which is
+// closed once all the items are sent. The context is canceled as soon as the term changes or the select ends,
+// so a slow query can be abandoned.
+type QueryFunc func(ctx context.Context, term string) (interface{}, error)
+
+// querier runs the Query of a select each time the searched term changes, loading the items it returns into the
+// list. Like the loader, its state is guarded by the select's mutex.
+type querier struct {
+	query QueryFunc
+	delay time.Duration
+
+	list *list.List
+	mu   *sync.Mutex
+	draw func()
+
+	// ld loads the items of the latest query and err holds its error, if any.
+	ld  *loader
+	err error
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// search cancels the running query and starts a new one for term once the delay has passed. It must be called
+// with the mutex held.
+func (q *querier) search(ctx context.Context, term string) {
+	if q.cancel != nil {
+		q.cancel()
+	}
+
+	ctx, q.cancel = context.WithCancel(ctx)
+
+	q.err = nil
+	q.list.Replace()
+
+	items := make(chan interface{})
+
+	q.wg.Add(1)
+	go func() {
+		defer q.wg.Done()
+		defer close(items)
+
+		err := q.run(ctx, term, items)
+		if err == nil {
+			return
+		}
+
+		q.mu.Lock()
+		defer q.mu.Unlock()
+
+		// the search is stale once its context is canceled, which only happens with the mutex held.
+		if ctx.Err() == nil {
+			q.err = err
+		}
+	}()
+
+	q.ld = &loader{items: reflect.ValueOf(items), loading: true}
+	q.ld.start(ctx, &q.wg, q.list, q.mu, q.draw)
+}
+
+// run waits for the delay and sends the items returned by the query to items.
+func (q *querier) run(ctx context.Context, term string, items chan<- interface{}) error {
+	timer := time.NewTimer(q.delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+		return nil
+	}
+
+	result, err := q.query(ctx, term)
+	if err != nil {
+		return err
+	}
+
+	v := reflect.ValueOf(result)
+	switch v.Kind() {
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			select {
+			case items <- v.Index(i).Interface():
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	case reflect.Chan:
+		cases := []reflect.SelectCase{
+			{Dir: reflect.SelectRecv, Chan: v},
+			{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())},
+		}
+
+		for {
+			chosen, item, ok := reflect.Select(cases)
+			if chosen == 1 || !ok {
+				return nil
+			}
+
+			select {
+			case items <- item.Interface():
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	default:
+		return fmt.Errorf("query returned %T, expected a slice or a channel", result)
+	}
+
+	return nil
+}
+
+// stop cancels the running query and waits for all the background goroutines to return.
+func (q *querier) stop() {
+	if q.cancel != nil {
+		q.cancel()
+	}
+	q.wg.Wait()
+}
+
+// queryAll runs the query of the select for term and returns all of its items, for when the select cannot be
+// displayed.
+func (s *Select) queryAll(ctx context.Context, term string) (reflect.Value, error) {
+	result, err := s.Query(ctx, term)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	v := reflect.ValueOf(result)
+	switch v.Kind() {
+	case reflect.Slice:
+		return v, nil
+	case reflect.Chan:
+		return receiveAll(v), nil
+	default:
+		return reflect.Value{}, fmt.Errorf("query returned %T, expected a slice or a channel", result)
+	}
+}
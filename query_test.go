@@ -0,0 +1,198 @@
+package promptui
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/manifoldco/promptui/promptuitest"
+)
+
+func TestSelectQuery(t *testing.T) {
+	packages := []string{"promptui", "readline", "prometheus", "cobra"}
+
+	search := func(ctx context.Context, term string) (interface{}, error) {
+		var found []string
+		for _, p := range packages {
+			if strings.HasPrefix(p, term) {
+				found = append(found, p)
+			}
+		}
+		return found, nil
+	}
+
+	screen := func(term *promptuitest.Terminal, text string) func() bool {
+		return func() bool {
+			return strings.Contains(term.Screen(), text)
+		}
+	}
+
+	t.Run("lists the items returned for the searched term", func(t *testing.T) {
+		stdin, w := io.Pipe()
+		defer w.Close()
+
+		term := promptuitest.New()
+
+		var mu sync.Mutex
+		var terms []string
+
+		s := Select{
+			Label: "Package",
+			Query: func(ctx context.Context, term string) (interface{}, error) {
+				mu.Lock()
+				terms = append(terms, term)
+				mu.Unlock()
+				return search(ctx, term)
+			},
+			QueryDelay: 20 * time.Millisecond,
+			Stdin:      stdin,
+			Stdout:     term.Stdout(),
+		}
+
+		type result struct {
+			idx   int
+			value string
+			err   error
+		}
+		done := make(chan result)
+		go func() {
+			idx, value, err := s.Run()
+			done <- result{idx, value, err}
+		}()
+
+		waitFor(t, "the initial query", screen(term, "cobra"))
+
+		w.Write([]byte("prom"))
+		waitFor(t, "the searched items", func() bool {
+			return strings.Contains(term.Screen(), "prometheus") && !strings.Contains(term.Screen(), "cobra")
+		})
+
+		w.Write([]byte(promptuitest.Down))
+		w.Write([]byte(promptuitest.Enter))
+
+		r := <-done
+		if r.err != nil {
+			t.Fatalf("Unexpected error %v", r.err)
+		}
+
+		if r.idx != 1 || r.value != "prometheus" {
+			t.Errorf("expected 1 %q, got %d %q", "prometheus", r.idx, r.value)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if len(terms) != 2 || terms[1] != "prom" {
+			t.Errorf("expected the query to run once for the typed term, got %q", terms)
+		}
+	})
+
+	t.Run("cancels stale queries", func(t *testing.T) {
+		stdin, w := io.Pipe()
+		defer w.Close()
+
+		term := promptuitest.New()
+		started := make(chan struct{})
+		canceled := make(chan string, 1)
+
+		s := Select{
+			Label: "Package",
+			Query: func(ctx context.Context, term string) (interface{}, error) {
+				if term == "p" {
+					close(started)
+					<-ctx.Done()
+					canceled <- term
+					return nil, ctx.Err()
+				}
+				return search(ctx, term)
+			},
+			QueryDelay: time.Millisecond,
+			Stdin:      stdin,
+			Stdout:     term.Stdout(),
+		}
+
+		done := make(chan error)
+		go func() {
+			_, _, err := s.Run()
+			done <- err
+		}()
+
+		waitFor(t, "the initial query", screen(term, "cobra"))
+		w.Write([]byte("p"))
+
+		<-started
+		w.Write([]byte("r"))
+
+		select {
+		case got := <-canceled:
+			if got != "p" {
+				t.Errorf("expected the query for %q to be canceled, got %q", "p", got)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for the stale query to be canceled")
+		}
+
+		waitFor(t, "the new query", screen(term, "prometheus"))
+
+		if strings.Contains(term.Screen(), "context canceled") {
+			t.Errorf("expected the error of the stale query to be ignored")
+		}
+
+		w.Write([]byte(promptuitest.Enter))
+
+		if err := <-done; err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+	})
+
+	t.Run("renders errors", func(t *testing.T) {
+		stdin, w := io.Pipe()
+		defer w.Close()
+
+		term := promptuitest.New()
+
+		s := Select{
+			Label: "Package",
+			Query: func(ctx context.Context, term string) (interface{}, error) {
+				return nil, errors.New("registry unavailable")
+			},
+			QueryDelay: time.Millisecond,
+			Stdin:      stdin,
+			Stdout:     term.Stdout(),
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() {
+			_, _, err := s.RunContext(ctx)
+			done <- err
+		}()
+
+		waitFor(t, "the error", screen(term, "registry unavailable"))
+		cancel()
+
+		if err := <-done; err != context.Canceled {
+			t.Errorf("expected %v, got %v", context.Canceled, err)
+		}
+	})
+
+	t.Run("queries in line mode", func(t *testing.T) {
+		stdin := pipeInput(t, "prom\n2\n")
+		defer stdin.Close()
+
+		s := Select{Label: "Package", Query: search, Stdin: stdin, Stdout: &nopWriteCloser{}}
+
+		idx, value, err := s.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if idx != 1 || value != "prometheus" {
+			t.Errorf("expected 1 %q, got %d %q", "prometheus", idx, value)
+		}
+	})
+}
@@ -17,10 +17,15 @@ var (
 // ScreenBuf is a convenient way to write to terminal screens. It creates,
 // clears and, moves up or down lines as needed to write the output to the
 // terminal using ANSI escape codes.
+//
+// When the width of the terminal is set, lines wider than it are accounted
+// for the rows they wrap onto, so that they are cleared and moved over
+// properly.
 type ScreenBuf struct {
 	w      io.Writer
 	buf    *bytes.Buffer
 	reset  bool
+	width  int
 	cursor int
 	height int
 }
@@ -30,6 +35,16 @@ func New(w io.Writer) *ScreenBuf {
 	return &ScreenBuf{buf: &bytes.Buffer{}, w: w}
 }
 
+// SetWidth sets the number of columns of the terminal, after which lines
+// wrap. A width of 0, the default, assumes lines never wrap. It can be
+// changed between writes, such as when the terminal is resized.
+func (s *ScreenBuf) SetWidth(width int) {
+	if width < 0 {
+		width = 0
+	}
+	s.width = width
+}
+
 // Reset truncates the underlining buffer and marks all its previous lines to be
 // cleared during the next Write.
 func (s *ScreenBuf) Reset() {
@@ -70,44 +85,74 @@ func (s *ScreenBuf) Write(b []byte) (int, error) {
 		}
 	}
 
-	switch {
-	case s.cursor == s.height:
-		n, err := s.buf.Write(clearLine)
-		if err != nil {
-			return n, err
-		}
-
-		n, err = s.buf.Write(b)
-		if err != nil {
-			return n, err
-		}
+	if s.cursor > s.height {
+		return 0, fmt.Errorf("Invalid write cursor position (%d) exceeded line height: %d", s.cursor, s.height)
+	}
 
-		_, err = s.buf.Write([]byte("\n"))
-		if err != nil {
-			return n, err
-		}
+	rows := s.rows(b)
 
-		s.height++
-		s.cursor++
-		return n, nil
-	case s.cursor < s.height:
-		n, err := s.buf.Write(clearLine)
+	// only the first row of the line is cleared below, so the previous output on the rows it wraps onto is
+	// cleared first.
+	down := 0
+	for down+1 < rows && s.cursor+down+1 < s.height {
+		_, err := s.buf.Write(moveDown)
 		if err != nil {
-			return n, err
+			return 0, err
 		}
-		n, err = s.buf.Write(b)
+		_, err = s.buf.Write(clearLine)
 		if err != nil {
-			return n, err
+			return 0, err
 		}
-		n, err = s.buf.Write(moveDown)
+		down++
+	}
+	for i := 0; i < down; i++ {
+		_, err := s.buf.Write(moveUp)
 		if err != nil {
-			return n, err
+			return 0, err
 		}
-		s.cursor++
-		return n, nil
-	default:
-		return 0, fmt.Errorf("Invalid write cursor position (%d) exceeded line height: %d", s.cursor, s.height)
 	}
+
+	n, err := s.buf.Write(clearLine)
+	if err != nil {
+		return n, err
+	}
+
+	n, err = s.buf.Write(b)
+	if err != nil {
+		return n, err
+	}
+
+	// the row below the previous output exists, as the cursor is left there by Flush, while the next ones do not
+	// and are created by a newline.
+	next := []byte("\n")
+	if s.cursor+rows <= s.height {
+		next = moveDown
+	}
+
+	_, err = s.buf.Write(next)
+	if err != nil {
+		return n, err
+	}
+
+	s.cursor += rows
+	if s.cursor > s.height {
+		s.height = s.cursor
+	}
+
+	return n, nil
+}
+
+// rows returns the number of rows of the terminal the line b is displayed on.
+func (s *ScreenBuf) rows(b []byte) int {
+	if s.width == 0 {
+		return 1
+	}
+
+	w := Width(string(b))
+	if w == 0 {
+		return 1
+	}
+	return (w + s.width - 1) / s.width
 }
 
 // Flush writes any buffered data to the underlying io.Writer, ensuring that any pending data is displayed.
@@ -2,10 +2,15 @@ package screenbuf
 
 import (
 	"bytes"
+	"reflect"
 	"testing"
 )
 
 func TestScreen(t *testing.T) {
+	defer func(c, u, d []byte) {
+		clearLine, moveUp, moveDown = c, u, d
+	}(clearLine, moveUp, moveDown)
+
 	// overwrite regular movement codes for easier visualization
 	clearLine = []byte("\\c")
 	moveUp = []byte("\\u")
@@ -132,3 +137,126 @@ func TestScreen(t *testing.T) {
 		})
 	}
 }
+
+func TestScreenDisplay(t *testing.T) {
+	term := NewTerminal(80)
+	s := New(term)
+
+	tcs := []struct {
+		scenario string
+		lines    []string
+		expect   []string
+		reset    bool
+		clear    bool
+	}{
+		{
+			scenario: "initial write",
+			lines:    []string{"Line One", "Line Two"},
+			expect:   []string{"Line One", "Line Two"},
+		},
+		{
+			scenario: "rewrite in place",
+			lines:    []string{"line one", "line two"},
+			expect:   []string{"line one", "line two"},
+		},
+		{
+			scenario: "write fewer lines",
+			lines:    []string{"only line"},
+			expect:   []string{"only line"},
+		},
+		{
+			scenario: "reset and write more lines",
+			lines:    []string{"a", "b", "c"},
+			expect:   []string{"a", "b", "c"},
+			reset:    true,
+		},
+		{
+			scenario: "clear",
+			lines:    []string{"a"},
+			expect:   []string{},
+			clear:    true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.scenario, func(t *testing.T) {
+			if tc.reset {
+				s.Reset()
+			}
+
+			for _, line := range tc.lines {
+				_, err := s.WriteString(line)
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+			}
+
+			if tc.clear {
+				s.Reset()
+				if err := s.Clear(); err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+			}
+
+			if err := s.Flush(); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if got := term.Lines(); !reflect.DeepEqual(tc.expect, got) {
+				t.Errorf("expected %q, got %q", tc.expect, got)
+			}
+		})
+	}
+}
+
+func TestScreenDisplayWrap(t *testing.T) {
+	term := NewTerminal(10)
+	s := New(term)
+	s.SetWidth(10)
+
+	frames := []struct {
+		scenario string
+		lines    []string
+		expect   []string
+	}{
+		{
+			scenario: "initial write",
+			lines:    []string{"short", "a line wrapping twice", "\x1b[1mstyled\x1b[0m"},
+			expect:   []string{"short", "a line wra", "pping twic", "e", "styled"},
+		},
+		{
+			scenario: "rewrite with shorter lines",
+			lines:    []string{"one", "two", "three"},
+			expect:   []string{"one", "two", "three"},
+		},
+		{
+			scenario: "rewrite with longer lines",
+			lines:    []string{"a line wrapping", "second line wrapping"},
+			expect:   []string{"a line wra", "pping", "second lin", "e wrapping"},
+		},
+		{
+			scenario: "rewrite with a single line",
+			lines:    []string{"done"},
+			expect:   []string{"done"},
+		},
+	}
+
+	for _, tc := range frames {
+		t.Run(tc.scenario, func(t *testing.T) {
+			for _, line := range tc.lines {
+				_, err := s.WriteString(line)
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+			}
+
+			if err := s.Flush(); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if got := term.Lines(); !reflect.DeepEqual(tc.expect, got) {
+				t.Errorf("expected %q, got %q", tc.expect, got)
+			}
+		})
+	}
+}
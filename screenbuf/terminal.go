@@ -0,0 +1,303 @@
+package screenbuf
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Style is the set of graphic attributes applied to a cell by SGR escape sequences.
+type Style struct {
+	Bold      bool
+	Faint     bool
+	Italic    bool
+	Underline bool
+	Inverse   bool
+
+	// FG and BG are the SGR parameters of the foreground and background colors, such as "31" for red or
+	// "38;5;202" for a 256 colors index. They are empty for the terminal's default colors.
+	FG string
+	BG string
+}
+
+// Cell is a single character displayed on a Terminal along with its style.
+type Cell struct {
+	Rune  rune
+	Style Style
+}
+
+// Terminal is an in-memory terminal emulator. It understands the escape sequences written by ScreenBuf to
+// move between lines and clear them, carriage returns, newlines and SGR styles, and ignores any other control
+// sequence. It lets tests assert on what the user actually sees rather than on raw escape sequences.
+//
+// The screen has a fixed width, after which lines wrap, and grows downward without scrolling so that
+// everything written remains inspectable.
+type Terminal struct {
+	width  int
+	rows   [][]Cell
+	row    int
+	col    int
+	style  Style
+	hidden bool
+
+	// pending holds an incomplete escape sequence until the rest of it is written.
+	pending []rune
+}
+
+// NewTerminal creates a Terminal with the given number of columns. A width of 0 disables line wrapping.
+func NewTerminal(width int) *Terminal {
+	return &Terminal{width: width, rows: [][]Cell{nil}}
+}
+
+// Write interprets b as terminal output. It never fails.
+func (t *Terminal) Write(b []byte) (int, error) {
+	input := append(t.pending, []rune(string(b))...)
+	t.pending = nil
+
+	for i := 0; i < len(input); i++ {
+		r := input[i]
+
+		switch r {
+		case '\x1b':
+			n, ok := t.escape(input[i:])
+			if !ok {
+				t.pending = append([]rune{}, input[i:]...)
+				return len(b), nil
+			}
+			i += n - 1
+		case '\r':
+			t.col = 0
+		case '\n':
+			t.col = 0
+			t.moveTo(t.row + 1)
+		case '\b':
+			if t.col > 0 {
+				t.col--
+			}
+		case '\a':
+		default:
+			t.put(r)
+		}
+	}
+
+	return len(b), nil
+}
+
+// Lines returns the text displayed on the screen, one string per line, without styles, trailing spaces and
+// trailing empty lines.
+func (t *Terminal) Lines() []string {
+	lines := make([]string, len(t.rows))
+	for i, row := range t.rows {
+		runes := make([]rune, len(row))
+		for j, c := range row {
+			runes[j] = c.Rune
+		}
+		lines[i] = strings.TrimRight(string(runes), " ")
+	}
+
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// String returns the text displayed on the screen with a newline after each line. See Lines.
+func (t *Terminal) String() string {
+	var b strings.Builder
+	for _, l := range t.Lines() {
+		b.WriteString(l)
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// Cells returns a copy of the cells displayed on the screen, one slice per line. Lines only hold the cells
+// which have been written to, so they can be of different lengths.
+func (t *Terminal) Cells() [][]Cell {
+	cells := make([][]Cell, len(t.rows))
+	for i, row := range t.rows {
+		cells[i] = append([]Cell(nil), row...)
+	}
+	return cells
+}
+
+// Width returns the number of columns of the terminal, 0 if lines do not wrap.
+func (t *Terminal) Width() int {
+	return t.width
+}
+
+// Cursor returns the current position of the cursor.
+func (t *Terminal) Cursor() (row, col int) {
+	return t.row, t.col
+}
+
+// CursorHidden reports whether the cursor has been hidden.
+func (t *Terminal) CursorHidden() bool {
+	return t.hidden
+}
+
+// escape applies the escape sequence at the start of seq, returning its length. It returns false if seq holds
+// an incomplete sequence.
+func (t *Terminal) escape(seq []rune) (int, bool) {
+	if len(seq) < 2 {
+		return 0, false
+	}
+
+	if seq[1] != '[' {
+		return 2, true
+	}
+
+	for i := 2; i < len(seq); i++ {
+		if seq[i] >= 0x40 && seq[i] <= 0x7e {
+			t.csi(string(seq[2:i]), seq[i])
+			return i + 1, true
+		}
+	}
+
+	return 0, false
+}
+
+func (t *Terminal) csi(params string, final rune) {
+	n := 1
+	if v, err := strconv.Atoi(params); err == nil && v > 0 {
+		n = v
+	}
+
+	switch final {
+	case 'A':
+		t.moveTo(t.row - n)
+	case 'B':
+		t.moveTo(t.row + n)
+	case 'C':
+		t.col += n
+	case 'D':
+		t.col -= n
+		if t.col < 0 {
+			t.col = 0
+		}
+	case 'G':
+		t.col = n - 1
+	case 'K':
+		switch params {
+		case "2":
+			t.rows[t.row] = nil
+		case "", "0":
+			t.truncate()
+		}
+	case 'J':
+		if params == "" || params == "0" {
+			t.truncate()
+			t.rows = t.rows[:t.row+1]
+		}
+	case 'm':
+		t.sgr(params)
+	case 'h', 'l':
+		if params == "?25" {
+			t.hidden = final == 'l'
+		}
+	}
+}
+
+// sgr applies the Select Graphic Rendition parameters to the current style.
+func (t *Terminal) sgr(params string) {
+	codes := strings.Split(params, ";")
+
+	for i := 0; i < len(codes); i++ {
+		code, _ := strconv.Atoi(codes[i])
+
+		switch {
+		case code == 0:
+			t.style = Style{}
+		case code == 1:
+			t.style.Bold = true
+		case code == 2:
+			t.style.Faint = true
+		case code == 3:
+			t.style.Italic = true
+		case code == 4:
+			t.style.Underline = true
+		case code == 7:
+			t.style.Inverse = true
+		case code == 22:
+			t.style.Bold = false
+			t.style.Faint = false
+		case code == 23:
+			t.style.Italic = false
+		case code == 24:
+			t.style.Underline = false
+		case code == 27:
+			t.style.Inverse = false
+		case code == 39:
+			t.style.FG = ""
+		case code == 49:
+			t.style.BG = ""
+		case code == 38 || code == 48:
+			n := extendedColorLen(codes[i+1:])
+			color := strings.Join(codes[i:i+1+n], ";")
+			if code == 38 {
+				t.style.FG = color
+			} else {
+				t.style.BG = color
+			}
+			i += n
+		case code >= 30 && code <= 37, code >= 90 && code <= 97:
+			t.style.FG = codes[i]
+		case code >= 40 && code <= 47, code >= 100 && code <= 107:
+			t.style.BG = codes[i]
+		}
+	}
+}
+
+// extendedColorLen returns the number of parameters following 38 or 48 which make up the color.
+func extendedColorLen(codes []string) int {
+	if len(codes) == 0 {
+		return 0
+	}
+
+	n := 0
+	switch codes[0] {
+	case "5":
+		n = 2
+	case "2":
+		n = 4
+	}
+
+	if n > len(codes) {
+		n = len(codes)
+	}
+	return n
+}
+
+func (t *Terminal) truncate() {
+	if t.col < len(t.rows[t.row]) {
+		t.rows[t.row] = t.rows[t.row][:t.col]
+	}
+}
+
+func (t *Terminal) moveTo(row int) {
+	if row < 0 {
+		row = 0
+	}
+
+	for len(t.rows) <= row {
+		t.rows = append(t.rows, nil)
+	}
+
+	t.row = row
+}
+
+func (t *Terminal) put(r rune) {
+	if t.width > 0 && t.col >= t.width {
+		t.col = 0
+		t.moveTo(t.row + 1)
+	}
+
+	line := t.rows[t.row]
+	for len(line) <= t.col {
+		line = append(line, Cell{Rune: ' '})
+	}
+	line[t.col] = Cell{Rune: r, Style: t.style}
+
+	t.rows[t.row] = line
+	t.col++
+}
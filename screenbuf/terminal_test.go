@@ -0,0 +1,98 @@
+package screenbuf
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTerminal(t *testing.T) {
+	tcs := []struct {
+		scenario string
+		writes   []string
+		expect   []string
+	}{
+		{
+			scenario: "plain lines",
+			writes:   []string{"one\ntwo\n"},
+			expect:   []string{"one", "two"},
+		},
+		{
+			scenario: "styles are dropped",
+			writes:   []string{"\x1b[1m\x1b[31mbold\x1b[0m red"},
+			expect:   []string{"bold red"},
+		},
+		{
+			scenario: "lines are redrawn in place",
+			writes:   []string{"\x1b[2K\rfirst\n\x1b[2K\rsecond\n", "\x1b[1A\x1b[1A", "\x1b[2K\rthird\x1b[1B\x1b[2K\r\x1b[1B"},
+			expect:   []string{"third"},
+		},
+		{
+			scenario: "escape sequences split across writes",
+			writes:   []string{"a\x1b[", "2K\rb"},
+			expect:   []string{"b"},
+		},
+		{
+			scenario: "long lines wrap",
+			writes:   []string{"abcdefghij"},
+			expect:   []string{"abcdefgh", "ij"},
+		},
+		{
+			scenario: "unknown sequences are ignored",
+			writes:   []string{"\x1b[?25lab\x1b[6n\x1b]c"},
+			expect:   []string{"abc"},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.scenario, func(t *testing.T) {
+			term := NewTerminal(8)
+			for _, w := range tc.writes {
+				term.Write([]byte(w))
+			}
+
+			if got := term.Lines(); !reflect.DeepEqual(tc.expect, got) {
+				t.Errorf("expected %q, got %q", tc.expect, got)
+			}
+		})
+	}
+}
+
+func TestTerminalStyles(t *testing.T) {
+	term := NewTerminal(0)
+	term.Write([]byte("\x1b[1m\x1b[31ma\x1b[0mb\x1b[4;38;5;202;48;2;1;2;3mc\x1b[24;39md"))
+
+	cells := term.Cells()
+	if len(cells) != 1 || len(cells[0]) != 4 {
+		t.Fatalf("expected a single line of 4 cells, got %v", cells)
+	}
+
+	exp := []Cell{
+		{Rune: 'a', Style: Style{Bold: true, FG: "31"}},
+		{Rune: 'b'},
+		{Rune: 'c', Style: Style{Underline: true, FG: "38;5;202", BG: "48;2;1;2;3"}},
+		{Rune: 'd', Style: Style{BG: "48;2;1;2;3"}},
+	}
+
+	if !reflect.DeepEqual(exp, cells[0]) {
+		t.Errorf("expected %+v, got %+v", exp, cells[0])
+	}
+}
+
+func TestTerminalCursor(t *testing.T) {
+	term := NewTerminal(0)
+	term.Write([]byte("\x1b[?25lone\ntwo"))
+
+	if !term.CursorHidden() {
+		t.Errorf("expected cursor to be hidden")
+	}
+
+	if row, col := term.Cursor(); row != 1 || col != 3 {
+		t.Errorf("expected cursor at 1,3, got %d,%d", row, col)
+	}
+
+	term.Write([]byte("\x1b[?25h"))
+
+	if term.CursorHidden() {
+		t.Errorf("expected cursor to be shown")
+	}
+}
@@ -0,0 +1,85 @@
+package screenbuf
+
+import (
+	"strings"
+
+	"github.com/chzyer/readline"
+)
+
+// Width returns the number of columns s is displayed on, ignoring escape sequences such as styles. Wide
+// characters such as CJK ones are two columns wide.
+func Width(s string) int {
+	w := 0
+	rs := []rune(s)
+
+	for i := 0; i < len(rs); i++ {
+		if rs[i] == '\x1b' {
+			i += escapeLen(rs[i:]) - 1
+			continue
+		}
+		w += readline.Runes{}.Width(rs[i])
+	}
+
+	return w
+}
+
+// Truncate cuts s to width columns, ending it with an ellipsis when it is wider. Escape sequences are kept so
+// that styles are not broken, and a reset is added after the ellipsis if s holds any.
+func Truncate(s string, width int) string {
+	if Width(s) <= width {
+		return s
+	}
+	if width <= 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	rs := []rune(s)
+	styled := false
+	w := 0
+
+	for i := 0; i < len(rs); i++ {
+		if rs[i] == '\x1b' {
+			n := escapeLen(rs[i:])
+			b.WriteString(string(rs[i : i+n]))
+			styled = true
+			i += n - 1
+			continue
+		}
+
+		// the last column is kept for the ellipsis.
+		rw := readline.Runes{}.Width(rs[i])
+		if w+rw > width-1 {
+			break
+		}
+		b.WriteRune(rs[i])
+		w += rw
+	}
+
+	b.WriteRune('…')
+	if styled {
+		b.WriteString(esc + "0m")
+	}
+
+	return b.String()
+}
+
+// escapeLen returns the length of the escape sequence at the start of seq, which is the rest of seq if the
+// sequence is incomplete.
+func escapeLen(seq []rune) int {
+	if len(seq) < 2 {
+		return len(seq)
+	}
+
+	if seq[1] != '[' {
+		return 2
+	}
+
+	for i := 2; i < len(seq); i++ {
+		if seq[i] >= 0x40 && seq[i] <= 0x7e {
+			return i + 1
+		}
+	}
+
+	return len(seq)
+}
@@ -0,0 +1,42 @@
+package screenbuf
+
+import "testing"
+
+func TestWidth(t *testing.T) {
+	tcs := []struct {
+		in  string
+		exp int
+	}{
+		{"", 0},
+		{"hello", 5},
+		{"\x1b[1m\x1b[31mhello\x1b[0m", 5},
+		{"\x1b[38;2;255;136;0m▸\x1b[0m item", 6},
+		{"日本", 4},
+	}
+
+	for _, tc := range tcs {
+		if got := Width(tc.in); got != tc.exp {
+			t.Errorf("expected width of %q to be %d, got %d", tc.in, tc.exp, got)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tcs := []struct {
+		in    string
+		width int
+		exp   string
+	}{
+		{"hello", 5, "hello"},
+		{"hello world", 8, "hello w…"},
+		{"\x1b[1mhello world\x1b[0m", 6, "\x1b[1mhello…\x1b[0m"},
+		{"日本語", 4, "日…"},
+		{"hello", 0, ""},
+	}
+
+	for _, tc := range tcs {
+		if got := Truncate(tc.in, tc.width); got != tc.exp {
+			t.Errorf("expected %q truncated to %d to be %q, got %q", tc.in, tc.width, tc.exp, got)
+		}
+	}
+}
@@ -2,11 +2,16 @@ package promptui
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"os"
+	"reflect"
+	"strings"
+	"sync"
 	"text/tabwriter"
 	"text/template"
+	"time"
 
 	"github.com/chzyer/readline"
 	"github.com/manifoldco/promptui/list"
@@ -28,6 +33,10 @@ type Select struct {
 	// inside the templates. For example, `{{ .Name }}` will display the name property of a struct.
 	Label interface{}
 
+	// ID identifies the select when looking up preset answers. When the Answers provider has an answer for the ID,
+	// Run returns it without asking the user. See the AnswerProvider docs for more info.
+	ID string
+
 	// Items are the items to display inside the list. It expect a slice of any kind of values, including strings.
 	//
 	// If using a slice of strings, promptui will use those strings directly into its base templates or the
@@ -36,11 +45,19 @@ type Select struct {
 	// inside the templates.
 	//
 	// For example, `{{ .Name }}` will display the name property of a struct.
+	//
+	// Items can also be a channel, such as a chan string, for items which take a while to be listed. The select
+	// is displayed right away and the items are appended to the list as they are received, with a spinner
+	// rendered by the Loading template until the channel is closed. Searches are applied to new items as well.
 	Items interface{}
 
 	// Size is the number of items that should appear on the select before scrolling is necessary. Defaults to 5.
 	Size int
 
+	// Overflow sets how items wider than the terminal are displayed, either wrapped on several lines, the
+	// default, or truncated with an ellipsis.
+	Overflow Overflow
+
 	// CursorPos is the initial position of the cursor.
 	CursorPos int
 
@@ -58,6 +75,19 @@ type Select struct {
 	// default templates are used. See the SelectTemplates docs for more info.
 	Templates *SelectTemplates
 
+	// Theme sets the icons, styles and default templates of the select. It defaults to DefaultTheme. See the Theme
+	// docs for more info.
+	Theme *Theme
+
+	// ColorProfile limits the styles displayed by the select, the ones beyond it being downgraded or stripped. It
+	// defaults to DefaultColorProfile, or to the profile detected from Stdout. Setting it overrides both, such as
+	// NoColor rendering plain text in tests or TrueColor keeping the styles when the output is piped.
+	ColorProfile ColorProfile
+
+	// Accessible runs the select in accessible mode, appending plain text lines suited to screen readers instead of
+	// redrawing its output. It is enabled for all of them by the Accessible variable.
+	Accessible bool
+
 	// Keys is the set of keys used in select mode to control the command line interface. See the SelectKeys docs for
 	// more info.
 	Keys *SelectKeys
@@ -69,12 +99,40 @@ type Select struct {
 	// it is implemented.
 	Searcher list.Searcher
 
+	// Ranker can be implemented instead of Searcher to rank the items matching the searched term, such as the
+	// fuzzy matching scorer returned by list.Fuzzy. The matching items are sorted by score and the text matching
+	// the term can be highlighted with the highlight template function. See the list.Ranker docs for more info.
+	Ranker list.Ranker
+
 	// StartInSearchMode sets whether or not the select mode should start in search mode or selection mode.
 	// For search mode to work, the Search property must be implemented.
 	StartInSearchMode bool
 
+	// Query can be implemented to get the items from a search backend instead of Items. When set, the select
+	// always runs in search mode and the searched term is passed to Query each time it changes, the returned
+	// items replacing the list. Errors returned by Query are displayed with the Error template. The index returned
+	// by Run is the position of the selected item inside the items returned by the last query.
+	Query QueryFunc
+
+	// QueryDelay is the time to wait after the last key press before running the Query, so that it does not run
+	// for each typed character. Defaults to 200 milliseconds.
+	QueryDelay time.Duration
+
 	list *list.List
 
+	// multi holds the checked items when the select is driven by a MultiSelect.
+	multi *multiState
+
+	// received holds the items of a channel or a query once they have all been received, for preset answers and
+	// line mode.
+	received reflect.Value
+
+	// matched holds the spans matched by the Ranker in the item being rendered, for the highlight function.
+	matched []list.Span
+
+	// theme is the resolved theme of the select, set when preparing its templates.
+	theme *Theme
+
 	// A function that determines how to render the cursor
 	Pointer Pointer
 
@@ -99,6 +157,9 @@ type SelectKeys struct {
 
 	// Search is the key used to trigger the search mode for the list. Default to the "/" key.
 	Search Key
+
+	// Toggle is the key used by MultiSelect to check or uncheck the active element. Defaults to the space key.
+	Toggle Key
 }
 
 // Key defines a keyboard code and a display representation for the help menu.
@@ -116,24 +177,27 @@ type Key struct {
 // text/template syntax. Custom state, colors and background color are available for use inside
 // the templates and are documented inside the Variable section of the docs.
 //
-// Examples
+// # Examples
 //
 // text/templates use a special notation to display programmable content. Using the double bracket notation,
 // the value can be printed with specific helper functions. For example
 //
 // This displays the value given to the template as pure, unstylized text. Structs are transformed to string
 // with this notation.
-// 	'{{ . }}'
+//
+//	'{{ . }}'
 //
 // This displays the name property of the value colored in cyan
-// 	'{{ .Name | cyan }}'
+//
+//	'{{ .Name | cyan }}'
 //
 // This displays the label property of value colored in red with a cyan background-color
-// 	'{{ .Label | red | cyan }}'
+//
+//	'{{ .Label | red | cyan }}'
 //
 // See the doc of text/template for more info: https://golang.org/pkg/text/template/
 //
-// Notes
+// # Notes
 //
 // Setting any of these templates will remove the icons from the default templates. They must
 // be added back in each of their specific templates. The styles.go constants contains the default icons.
@@ -165,19 +229,38 @@ type SelectTemplates struct {
 	// it shows keys for movement and search.
 	Help string
 
+	// ValidationError is a text/template for the message displayed below the list when the selection cannot
+	// be submitted, for example when a MultiSelect has fewer checked items than its Min.
+	ValidationError string
+
+	// Loading is a text/template for the line displayed below the help while the items received from a channel
+	// are loading. The Spinner field holds the current frame of the spinner and Count the number of items
+	// received so far.
+	Loading string
+
+	// Error is a text/template for the error returned by the Query of the select, displayed instead of the items.
+	Error string
+
 	// FuncMap is a map of helper functions that can be used inside of templates according to the text/template
 	// documentation.
 	//
 	// By default, FuncMap contains the color functions used to color the text in templates. If FuncMap
 	// is overridden, the colors functions must be added in the override from promptui.FuncMap to work.
+	//
+	// The highlight function is always available in the Active and Inactive templates. When the select is searched
+	// with a Ranker, it highlights the runes of its argument matching the searched term, as in
+	// '{{ .Name | highlight }}'. The text given to highlight must be the one matched by the Ranker.
 	FuncMap template.FuncMap
 
-	label    *template.Template
-	active   *template.Template
-	inactive *template.Template
-	selected *template.Template
-	details  *template.Template
-	help     *template.Template
+	label      *template.Template
+	active     *template.Template
+	inactive   *template.Template
+	selected   *template.Template
+	details    *template.Template
+	help       *template.Template
+	validation *template.Template
+	loading    *template.Template
+	err        *template.Template
 }
 
 // SearchPrompt is the prompt displayed in search mode.
@@ -191,6 +274,12 @@ func (s *Select) Run() (int, string, error) {
 	return s.RunCursorAt(s.CursorPos, 0)
 }
 
+// RunContext executes the select list like Run, but stops it as soon as the given context is done. When that
+// happens, the list is cleared from the terminal and the context's error is returned.
+func (s *Select) RunContext(ctx context.Context) (int, string, error) {
+	return s.runCursorAt(ctx, s.CursorPos, 0)
+}
+
 // RunCursorAt executes the select list, initializing the cursor to the given
 // position. Invalid cursor positions will be clamped to valid values.  It
 // displays the label and the list of items, asking the user to chose any value
@@ -198,15 +287,29 @@ func (s *Select) Run() (int, string, error) {
 // from the command prompt or it has received a valid value. It will return
 // the value and an error if any occurred during the select's execution.
 func (s *Select) RunCursorAt(cursorPos, scroll int) (int, string, error) {
+	return s.runCursorAt(context.Background(), cursorPos, scroll)
+}
+
+func (s *Select) runCursorAt(ctx context.Context, cursorPos, scroll int) (int, string, error) {
 	if s.Size == 0 {
 		s.Size = 5
 	}
 
-	l, err := list.New(s.Items, s.Size)
+	items := s.Items
+	if items == nil && s.Query != nil {
+		items = []interface{}{}
+	}
+	if v := reflect.ValueOf(items); v.Kind() == reflect.Chan {
+		// the list starts empty and is filled by the select as items are received.
+		items = reflect.MakeSlice(reflect.SliceOf(v.Type().Elem()), 0, 0).Interface()
+	}
+
+	l, err := list.New(items, s.Size)
 	if err != nil {
 		return 0, "", err
 	}
 	l.Searcher = s.Searcher
+	l.Ranker = s.Ranker
 
 	s.list = l
 
@@ -216,10 +319,32 @@ func (s *Select) RunCursorAt(cursorPos, scroll int) (int, string, error) {
 	if err != nil {
 		return 0, "", err
 	}
-	return s.innerRun(cursorPos, scroll, ' ')
+	return s.innerRun(ctx, cursorPos, scroll, ' ')
 }
 
-func (s *Select) innerRun(cursorPos, scroll int, top rune) (int, string, error) {
+func (s *Select) innerRun(ctx context.Context, cursorPos, scroll int, top rune) (int, string, error) {
+	if answer, ok := presetAnswer(s.ID); ok {
+		if s.Query != nil {
+			items, err := s.queryAll(ctx, answer)
+			if err != nil {
+				return 0, "", err
+			}
+			s.received = items
+		}
+		return s.answer(answer, cursorPos)
+	}
+
+	if s.Accessible || Accessible {
+		return s.runAccessible(ctx, cursorPos)
+	}
+
+	if !isInteractive(s.Stdin) {
+		if s.Query != nil {
+			return s.runQueryLine(ctx, cursorPos)
+		}
+		return s.runLine(cursorPos)
+	}
+
 	c := &readline.Config{
 		Stdin:  s.Stdin,
 		Stdout: s.Stdout,
@@ -229,7 +354,8 @@ func (s *Select) innerRun(cursorPos, scroll int, top rune) (int, string, error)
 		return 0, "", err
 	}
 
-	c.Stdin = readline.NewCancelableStdin(c.Stdin)
+	stdin := readline.NewCancelableStdin(c.Stdin)
+	c.Stdin = stdin
 
 	if s.IsVimMode {
 		c.VimMode = true
@@ -244,56 +370,47 @@ func (s *Select) innerRun(cursorPos, scroll int, top rune) (int, string, error)
 	}
 
 	rl.Write([]byte(hideCursor))
-	sb := screenbuf.New(rl)
+	sb := screenbuf.New(newProfileWriter(rl, s.ColorProfile, s.Stdout))
+
+	stop := cancelOnDone(ctx, stdin)
+	defer stop()
 
 	cur := NewCursor("", s.Pointer, false)
 
-	canSearch := s.Searcher != nil
-	searchMode := s.StartInSearchMode
+	canSearch := s.Searcher != nil || s.Ranker != nil
+	searchMode := s.StartInSearchMode || s.Query != nil
 	s.list.SetCursor(cursorPos)
 	s.list.SetStart(scroll)
 
-	c.SetListener(func(line []rune, pos int, key rune) ([]rune, int, bool) {
-		switch {
-		case key == KeyEnter:
-			return nil, 0, true
-		case key == s.Keys.Next.Code || (key == 'j' && !searchMode):
-			s.list.Next()
-		case key == s.Keys.Prev.Code || (key == 'k' && !searchMode):
-			s.list.Prev()
-		case key == s.Keys.Search.Code:
-			if !canSearch {
-				break
-			}
+	// mu guards the list and the screen, which are also updated in the background while the items load.
+	var mu sync.Mutex
+	var wg sync.WaitGroup
 
-			if searchMode {
-				searchMode = false
-				cur.Replace("")
-				s.list.CancelSearch()
-			} else {
-				searchMode = true
-			}
-		case key == KeyBackspace || key == KeyCtrlH:
-			if !canSearch || !searchMode {
-				break
-			}
+	loadCtx, cancelLoad := context.WithCancel(ctx)
+	defer cancelLoad()
 
-			cur.Backspace()
-			if len(cur.Get()) > 0 {
-				s.list.Search(cur.Get())
-			} else {
-				s.list.CancelSearch()
-			}
-		case key == s.Keys.PageUp.Code || (key == 'h' && !searchMode):
-			s.list.PageUp()
-		case key == s.Keys.PageDown.Code || (key == 'l' && !searchMode):
-			s.list.PageDown()
-		default:
-			if canSearch && searchMode {
-				cur.Update(string(line))
-				s.list.Search(cur.Get())
-			}
+	ld := newLoader(s.Items)
+
+	var q *querier
+	if s.Query != nil {
+		delay := s.QueryDelay
+		if delay == 0 {
+			delay = defaultQueryDelay
+		}
+		q = &querier{query: s.Query, delay: delay, list: s.list, mu: &mu}
+	}
+
+	// loading returns the loader of the items being displayed, if any.
+	loading := func() *loader {
+		if q != nil {
+			return q.ld
 		}
+		return ld
+	}
+
+	draw := func() {
+		width := terminalWidth(s.Stdout)
+		sb.SetWidth(width)
 
 		if searchMode {
 			header := SearchPrompt + cur.Format()
@@ -303,12 +420,27 @@ func (s *Select) innerRun(cursorPos, scroll int, top rune) (int, string, error)
 			sb.Write(help)
 		}
 
+		if ld := loading(); ld != nil && ld.loading {
+			data := struct {
+				Spinner string
+				Count   int
+			}{ld.spinner(s.theme.Icons.Spinner), ld.count}
+			sb.Write(render(s.Templates.loading, data))
+		}
+
 		label := render(s.Templates.label, s.Label)
 		sb.Write(label)
 
 		items, idx := s.list.Items()
 		last := len(items) - 1
 
+		var indices []int
+		if s.multi != nil {
+			indices = s.list.Indices()
+		}
+
+		matches := s.list.Matches()
+
 		for i, item := range items {
 			page := " "
 
@@ -327,18 +459,38 @@ func (s *Select) innerRun(cursorPos, scroll int, top rune) (int, string, error)
 
 			output := []byte(page + " ")
 
+			if s.multi != nil {
+				output = append(output, s.multi.mark(indices[i], s.theme.Icons)...)
+			}
+
+			s.matched = nil
+			if matches != nil {
+				s.matched = matches[i]
+			}
+
 			if i == idx {
 				output = append(output, render(s.Templates.active, item)...)
 			} else {
 				output = append(output, render(s.Templates.inactive, item)...)
 			}
 
+			if s.Overflow == OverflowTruncate && width > 0 {
+				output = []byte(screenbuf.Truncate(string(output), width))
+			}
+
 			sb.Write(output)
 		}
+		s.matched = nil
 
 		if idx == list.NotFound {
-			sb.WriteString("")
-			sb.WriteString("No results")
+			switch ld := loading(); {
+			case q != nil && q.err != nil:
+				sb.WriteString("")
+				sb.Write(render(s.Templates.err, q.err))
+			case ld == nil || !ld.loading:
+				sb.WriteString("")
+				sb.WriteString("No results")
+			}
 		} else {
 			active := items[idx]
 
@@ -348,11 +500,88 @@ func (s *Select) innerRun(cursorPos, scroll int, top rune) (int, string, error)
 			}
 		}
 
+		if s.multi != nil && s.multi.err != nil {
+			sb.Write(render(s.Templates.validation, s.multi.err))
+			s.multi.err = nil
+		}
+
 		sb.Flush()
+	}
+
+	c.SetListener(func(line []rune, pos int, key rune) ([]rune, int, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case key == KeyEnter:
+			return nil, 0, true
+		case s.multi != nil && key == s.Keys.Toggle.Code && !searchMode:
+			if _, idx := s.list.Items(); idx != list.NotFound {
+				s.multi.toggle(s.list.Index())
+			}
+		case key == s.Keys.Next.Code || (key == 'j' && !searchMode):
+			s.list.Next()
+		case key == s.Keys.Prev.Code || (key == 'k' && !searchMode):
+			s.list.Prev()
+		case key == s.Keys.Search.Code:
+			if !canSearch || q != nil {
+				break
+			}
+
+			if searchMode {
+				searchMode = false
+				cur.Replace("")
+				s.list.CancelSearch()
+			} else {
+				searchMode = true
+			}
+		case key == KeyBackspace || key == KeyCtrlH:
+			if q != nil {
+				cur.Backspace()
+				q.search(loadCtx, cur.Get())
+				break
+			}
+
+			if !canSearch || !searchMode {
+				break
+			}
+
+			cur.Backspace()
+			if len(cur.Get()) > 0 {
+				s.list.Search(cur.Get())
+			} else {
+				s.list.CancelSearch()
+			}
+		case key == s.Keys.PageUp.Code || (key == 'h' && !searchMode):
+			s.list.PageUp()
+		case key == s.Keys.PageDown.Code || (key == 'l' && !searchMode):
+			s.list.PageDown()
+		default:
+			if q != nil {
+				cur.Update(string(line))
+				q.search(loadCtx, cur.Get())
+			} else if canSearch && searchMode {
+				cur.Update(string(line))
+				s.list.Search(cur.Get())
+			}
+		}
+
+		draw()
 
 		return nil, 0, true
 	})
 
+	if ld != nil {
+		ld.start(loadCtx, &wg, s.list, &mu, draw)
+	}
+
+	if q != nil {
+		q.draw = draw
+		mu.Lock()
+		q.search(loadCtx, "")
+		mu.Unlock()
+	}
+
 	for {
 		_, err = rl.Readline()
 
@@ -366,11 +595,44 @@ func (s *Select) innerRun(cursorPos, scroll int, top rune) (int, string, error)
 			break
 		}
 
+		if wentBack(ctx) {
+			err = errBack
+			break
+		}
+
+		mu.Lock()
 		_, idx := s.list.Items()
-		if idx != list.NotFound {
+		mu.Unlock()
+
+		if idx == list.NotFound {
+			continue
+		}
+
+		if s.multi == nil {
+			break
+		}
+
+		s.multi.err = s.multi.validate()
+		if s.multi.err == nil {
 			break
 		}
+	}
+
+	cancelLoad()
+	wg.Wait()
+	if q != nil {
+		q.stop()
+	}
 
+	if err != nil && ctx.Err() != nil {
+		err = ctx.Err()
+	}
+
+	if err == errBack || (err != nil && err == ctx.Err()) {
+		clearScreen(sb)
+		rl.Write([]byte(showCursor))
+		rl.Close()
+		return 0, "", err
 	}
 
 	if err != nil {
@@ -391,8 +653,13 @@ func (s *Select) innerRun(cursorPos, scroll int, top rune) (int, string, error)
 	if s.HideSelected {
 		clearScreen(sb)
 	} else {
+		selected := item
+		if s.multi != nil {
+			selected = s.multi.values()
+		}
+
 		sb.Reset()
-		sb.Write(render(s.Templates.selected, item))
+		sb.Write(render(s.Templates.selected, selected))
 		sb.Flush()
 	}
 
@@ -402,6 +669,22 @@ func (s *Select) innerRun(cursorPos, scroll int, top rune) (int, string, error)
 	return s.list.Index(), fmt.Sprintf("%v", item), err
 }
 
+// itemValues returns the items of the select as a slice, receiving all of them first when they are streamed from
+// a channel.
+func (s *Select) itemValues() reflect.Value {
+	if s.received.IsValid() {
+		return s.received
+	}
+
+	items := reflect.ValueOf(s.Items)
+	if items.Kind() == reflect.Chan {
+		s.received = receiveAll(items)
+		return s.received
+	}
+
+	return items
+}
+
 // ScrollPosition returns the current scroll position.
 func (s *Select) ScrollPosition() int {
 	return s.list.Start()
@@ -417,11 +700,21 @@ func (s *Select) prepareTemplates() error {
 		tpls.FuncMap = FuncMap
 	}
 
+	s.theme = resolveTheme(s.Theme)
+
+	// highlight is bound to the select rendering the templates, so it is added to a copy of the FuncMap.
+	funcs := s.theme.funcs(tpls.FuncMap)
+	if _, ok := funcs["highlight"]; !ok {
+		funcs["highlight"] = func(v interface{}) string {
+			return highlight(fmt.Sprint(v), s.matched, s.theme.Styles.Highlight)
+		}
+	}
+
 	if tpls.Label == "" {
-		tpls.Label = fmt.Sprintf("%s {{.}}: ", IconInitial)
+		tpls.Label = s.theme.Select.Label
 	}
 
-	tpl, err := template.New("").Funcs(tpls.FuncMap).Parse(tpls.Label)
+	tpl, err := template.New("").Funcs(funcs).Parse(tpls.Label)
 	if err != nil {
 		return err
 	}
@@ -429,10 +722,10 @@ func (s *Select) prepareTemplates() error {
 	tpls.label = tpl
 
 	if tpls.Active == "" {
-		tpls.Active = fmt.Sprintf("%s {{ . | underline }}", IconSelect)
+		tpls.Active = s.theme.Select.Active
 	}
 
-	tpl, err = template.New("").Funcs(tpls.FuncMap).Parse(tpls.Active)
+	tpl, err = template.New("").Funcs(funcs).Parse(tpls.Active)
 	if err != nil {
 		return err
 	}
@@ -440,10 +733,10 @@ func (s *Select) prepareTemplates() error {
 	tpls.active = tpl
 
 	if tpls.Inactive == "" {
-		tpls.Inactive = "  {{.}}"
+		tpls.Inactive = s.theme.Select.Inactive
 	}
 
-	tpl, err = template.New("").Funcs(tpls.FuncMap).Parse(tpls.Inactive)
+	tpl, err = template.New("").Funcs(funcs).Parse(tpls.Inactive)
 	if err != nil {
 		return err
 	}
@@ -451,17 +744,17 @@ func (s *Select) prepareTemplates() error {
 	tpls.inactive = tpl
 
 	if tpls.Selected == "" {
-		tpls.Selected = fmt.Sprintf(`{{ "%s" | green }} {{ . | faint }}`, IconGood)
+		tpls.Selected = s.theme.Select.Selected
 	}
 
-	tpl, err = template.New("").Funcs(tpls.FuncMap).Parse(tpls.Selected)
+	tpl, err = template.New("").Funcs(funcs).Parse(tpls.Selected)
 	if err != nil {
 		return err
 	}
 	tpls.selected = tpl
 
 	if tpls.Details != "" {
-		tpl, err = template.New("").Funcs(tpls.FuncMap).Parse(tpls.Details)
+		tpl, err = template.New("").Funcs(funcs).Parse(tpls.Details)
 		if err != nil {
 			return err
 		}
@@ -470,18 +763,49 @@ func (s *Select) prepareTemplates() error {
 	}
 
 	if tpls.Help == "" {
-		tpls.Help = fmt.Sprintf(`{{ "Use the arrow keys to navigate:" | faint }} {{ .NextKey | faint }} ` +
-			`{{ .PrevKey | faint }} {{ .PageDownKey | faint }} {{ .PageUpKey | faint }} ` +
-			`{{ if .Search }} {{ "and" | faint }} {{ .SearchKey | faint }} {{ "toggles search" | faint }}{{ end }}`)
+		tpls.Help = s.theme.Select.Help
 	}
 
-	tpl, err = template.New("").Funcs(tpls.FuncMap).Parse(tpls.Help)
+	tpl, err = template.New("").Funcs(funcs).Parse(tpls.Help)
 	if err != nil {
 		return err
 	}
 
 	tpls.help = tpl
 
+	if tpls.ValidationError == "" {
+		tpls.ValidationError = s.theme.Select.ValidationError
+	}
+
+	tpl, err = template.New("").Funcs(funcs).Parse(tpls.ValidationError)
+	if err != nil {
+		return err
+	}
+
+	tpls.validation = tpl
+
+	if tpls.Loading == "" {
+		tpls.Loading = s.theme.Select.Loading
+	}
+
+	tpl, err = template.New("").Funcs(funcs).Parse(tpls.Loading)
+	if err != nil {
+		return err
+	}
+
+	tpls.loading = tpl
+
+	if tpls.Error == "" {
+		tpls.Error = s.theme.Select.Error
+	}
+
+	tpl, err = template.New("").Funcs(funcs).Parse(tpls.Error)
+	if err != nil {
+		return err
+	}
+
+	tpls.err = tpl
+
 	s.Templates = tpls
 
 	return nil
@@ -494,6 +818,10 @@ type SelectWithAdd struct {
 	// appended automatically to the label so it does not need to be added.
 	Label string
 
+	// ID identifies the select when looking up preset answers. When the Answers provider has an answer for the ID,
+	// Run returns it without asking the user. See the AnswerProvider docs for more info.
+	ID string
+
 	// Items are the items to display inside the list. Each item will be listed individually with the
 	// AddLabel as the first item of the list.
 	Items []string
@@ -515,6 +843,14 @@ type SelectWithAdd struct {
 
 	// HideHelp sets whether to hide help information.
 	HideHelp bool
+
+	// Theme sets the icons, styles and default templates of the select and of the prompt adding an item. It
+	// defaults to DefaultTheme. See the Theme docs for more info.
+	Theme *Theme
+
+	// Accessible runs the select and the prompt adding an item in accessible mode. See the Accessible variable
+	// for more info.
+	Accessible bool
 }
 
 // Run executes the select list. Its displays the label and the list of items, asking the user to chose any
@@ -525,6 +861,16 @@ type SelectWithAdd struct {
 // Otherwise, it will return the index and the value of the selected item. In any case, if an error is triggered, it
 // will also return the error as its third return value.
 func (sa *SelectWithAdd) Run() (int, string, error) {
+	return sa.RunContext(context.Background())
+}
+
+// RunContext executes the select list like Run, but stops it as soon as the given context is done, returning
+// the context's error.
+func (sa *SelectWithAdd) RunContext(ctx context.Context) (int, string, error) {
+	if answer, ok := presetAnswer(sa.ID); ok {
+		return sa.answer(ctx, answer)
+	}
+
 	if len(sa.Items) > 0 {
 		newItems := append([]string{sa.AddLabel}, sa.Items...)
 
@@ -534,13 +880,15 @@ func (sa *SelectWithAdd) Run() (int, string, error) {
 		}
 
 		s := Select{
-			Label:     sa.Label,
-			Items:     newItems,
-			IsVimMode: sa.IsVimMode,
-			HideHelp:  sa.HideHelp,
-			Size:      5,
-			list:      list,
-			Pointer:   sa.Pointer,
+			Label:      sa.Label,
+			Items:      newItems,
+			IsVimMode:  sa.IsVimMode,
+			HideHelp:   sa.HideHelp,
+			Size:       5,
+			list:       list,
+			Pointer:    sa.Pointer,
+			Theme:      sa.Theme,
+			Accessible: sa.Accessible,
 		}
 		s.setKeys()
 
@@ -549,22 +897,39 @@ func (sa *SelectWithAdd) Run() (int, string, error) {
 			return 0, "", err
 		}
 
-		selected, value, err := s.innerRun(1, 0, '+')
+		selected, value, err := s.innerRun(ctx, 1, 0, '+')
 		if err != nil || selected != 0 {
 			return selected - 1, value, err
 		}
 
 		// XXX run through terminal for windows
-		os.Stdout.Write([]byte(upLine(1) + "\r" + clearLine))
+		if isInteractive(nil) && !sa.Accessible && !Accessible {
+			os.Stdout.Write([]byte(upLine(1) + "\r" + clearLine))
+		}
 	}
 
 	p := Prompt{
-		Label:     sa.AddLabel,
-		Validate:  sa.Validate,
-		IsVimMode: sa.IsVimMode,
-		Pointer:   sa.Pointer,
+		Label:      sa.AddLabel,
+		Validate:   sa.Validate,
+		IsVimMode:  sa.IsVimMode,
+		Pointer:    sa.Pointer,
+		Theme:      sa.Theme,
+		Accessible: sa.Accessible,
 	}
-	value, err := p.Run()
+	value, err := p.RunContext(ctx)
+	return SelectedAdd, value, err
+}
+
+// answer returns the item matching a preset answer, or adds the answer as a new item if none matches.
+func (sa *SelectWithAdd) answer(ctx context.Context, answer string) (int, string, error) {
+	s := Select{Items: sa.Items}
+	idx, value, err := s.answer(answer, 0)
+	if err == nil && len(sa.Items) > 0 {
+		return idx, value, nil
+	}
+
+	p := Prompt{Validate: sa.Validate}
+	value, err = p.answer(ctx, answer)
 	return SelectedAdd, value, err
 }
 
@@ -578,6 +943,7 @@ func (s *Select) setKeys() {
 		PageUp:   Key{Code: KeyBackward, Display: KeyBackwardDisplay},
 		PageDown: Key{Code: KeyForward, Display: KeyForwardDisplay},
 		Search:   Key{Code: '/', Display: "/"},
+		Toggle:   Key{Code: ' ', Display: "space"},
 	}
 }
 
@@ -610,12 +976,14 @@ func (s *Select) renderHelp(b bool) []byte {
 		PageUpKey   string
 		Search      bool
 		SearchKey   string
+		ToggleKey   string
 	}{
 		NextKey:     s.Keys.Next.Display,
 		PrevKey:     s.Keys.Prev.Display,
 		PageDownKey: s.Keys.PageDown.Display,
 		PageUpKey:   s.Keys.PageUp.Display,
 		SearchKey:   s.Keys.Search.Display,
+		ToggleKey:   s.Keys.Toggle.Display,
 		Search:      b,
 	}
 
@@ -631,6 +999,47 @@ func render(tpl *template.Template, data interface{}) []byte {
 	return buf.Bytes()
 }
 
+// cancelOnDone closes stdin as soon as ctx is done, which makes any pending readline call return. The returned
+// function must be called once the prompt is over to release the watching goroutine.
+func cancelOnDone(ctx context.Context, stdin io.Closer) func() {
+	done := make(chan struct{})
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			stdin.Close()
+		case <-done:
+		}
+	}()
+
+	return func() {
+		close(done)
+	}
+}
+
+// highlight styles the spans of text matched by a list.Ranker.
+func highlight(text string, spans []list.Span, style func(interface{}) string) string {
+	if len(spans) == 0 {
+		return text
+	}
+
+	runes := []rune(text)
+
+	var out strings.Builder
+	last := 0
+	for _, sp := range spans {
+		if sp.Start < last || sp.End > len(runes) || sp.Start >= sp.End {
+			continue
+		}
+		out.WriteString(string(runes[last:sp.Start]))
+		out.WriteString(style(string(runes[sp.Start:sp.End])))
+		last = sp.End
+	}
+	out.WriteString(string(runes[last:]))
+
+	return out.String()
+}
+
 func clearScreen(sb *screenbuf.ScreenBuf) {
 	sb.Reset()
 	sb.Clear()
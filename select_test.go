@@ -2,8 +2,15 @@ package promptui
 
 import (
 	"bytes"
+	"context"
+	"io"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/manifoldco/promptui/list"
+	"github.com/manifoldco/promptui/promptuitest"
 	"github.com/manifoldco/promptui/screenbuf"
 )
 
@@ -166,3 +173,128 @@ func TestClearScreen(t *testing.T) {
 		t.Errorf("expected %q, got %q", except, got)
 	}
 }
+
+func TestSelectRunContext(t *testing.T) {
+	stdin, w := io.Pipe()
+	defer w.Close()
+
+	s := Select{
+		Label:  "Select Number",
+		Items:  []string{"Zero", "One"},
+		Stdin:  stdin,
+		Stdout: &nopWriteCloser{},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	_, _, err := s.RunContext(ctx)
+	if err != context.Canceled {
+		t.Errorf("expected %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestSelectRanker(t *testing.T) {
+	names := []string{"billing", "api-gateway", "gateway", "users"}
+
+	term := promptuitest.New()
+	term.Type("/", "gw", promptuitest.Enter)
+
+	s := Select{
+		Label: "Service",
+		Items: names,
+		Ranker: list.Fuzzy(func(index int) string {
+			return names[index]
+		}),
+		Templates: &SelectTemplates{
+			Active:   "> {{ . | highlight }}",
+			Inactive: "  {{ . | highlight }}",
+		},
+		Stdin:  term.Stdin(),
+		Stdout: term.Stdout(),
+	}
+
+	idx, result, err := s.Run()
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if idx != 2 || result != "gateway" {
+		t.Errorf("expected the best match 2 %q, got %d %q", "gateway", idx, result)
+	}
+
+	exp := "> " + highlight("gateway", []list.Span{{Start: 0, End: 1}, {Start: 4, End: 5}}, Styler(FGBold, FGCyan))
+	if !strings.Contains(term.Output(), exp) {
+		t.Errorf("expected the matched runes to be highlighted in %q", term.Output())
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	style := Styler(FGBold, FGCyan)
+
+	got := highlight("gateway", []list.Span{{Start: 0, End: 2}, {Start: 4, End: 5}}, style)
+	exp := style("ga") + "te" + style("w") + "ay"
+	if got != exp {
+		t.Errorf("expected %q, got %q", exp, got)
+	}
+
+	if got := highlight("gateway", nil, style); got != "gateway" {
+		t.Errorf("expected text without matches to be unchanged, got %q", got)
+	}
+}
+
+func TestSelectOverflow(t *testing.T) {
+	items := []string{"a short item", "an item much wider than the terminal", "another item wider than the terminal"}
+
+	t.Run("wraps long items", func(t *testing.T) {
+		term := promptuitest.NewWithWidth(20)
+		term.Type(promptuitest.Down, promptuitest.Down, promptuitest.Up, promptuitest.Enter)
+
+		s := Select{
+			Label:    "Item",
+			Items:    items,
+			HideHelp: true,
+			Stdin:    term.Stdin(),
+			Stdout:   term.Stdout(),
+		}
+
+		_, result, err := s.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if result != items[1] {
+			t.Errorf("expected %q, got %q", items[1], result)
+		}
+
+		exp := []string{"✔ an item much wider", " than the terminal"}
+		if got := term.Lines(); !reflect.DeepEqual(got, exp) {
+			t.Errorf("expected the list to be cleared, got %q", got)
+		}
+	})
+
+	t.Run("truncates long items", func(t *testing.T) {
+		term := promptuitest.NewWithWidth(20)
+		term.Type(promptuitest.Down)
+
+		s := Select{
+			Label:    "Item",
+			Items:    items,
+			HideHelp: true,
+			Overflow: OverflowTruncate,
+			Stdin:    term.Stdin(),
+			Stdout:   term.Stdout(),
+		}
+
+		s.Run()
+
+		out := term.Output()
+		if !strings.Contains(out, "an item much wi…") || !strings.Contains(out, "another item wi…") {
+			t.Errorf("expected the items to be truncated in %q", out)
+		}
+
+		if strings.Contains(out, "than the terminal") {
+			t.Errorf("expected the items not to wrap in %q", out)
+		}
+	})
+}
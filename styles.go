@@ -2,22 +2,42 @@
 
 package promptui
 
+// The glyphs of the default icons, displayed by themes with the styles of their IconStyles.
+const (
+	glyphInitial   = "?"
+	glyphGood      = "✔"
+	glyphWarn      = "⚠"
+	glyphBad       = "✗"
+	glyphSelect    = "▸"
+	glyphChecked   = "◉"
+	glyphUnchecked = "◯"
+)
+
 // These are the default icons used by promptui for select and prompts. These should not be overridden and instead
-// customized through the use of custom templates
+// customized through the use of a Theme or custom templates.
 var (
 	// IconInitial is the icon used when starting in prompt mode and the icon next to the label when
 	// starting in select mode.
-	IconInitial = Styler(FGBlue)("?")
+	IconInitial = Styler(FGBlue)(glyphInitial)
 
 	// IconGood is the icon used when a good answer is entered in prompt mode.
-	IconGood = Styler(FGGreen)("✔")
+	IconGood = Styler(FGGreen)(glyphGood)
 
 	// IconWarn is the icon used when a good, but potentially invalid answer is entered in prompt mode.
-	IconWarn = Styler(FGYellow)("⚠")
+	IconWarn = Styler(FGYellow)(glyphWarn)
 
 	// IconBad is the icon used when a bad answer is entered in prompt mode.
-	IconBad = Styler(FGRed)("✗")
+	IconBad = Styler(FGRed)(glyphBad)
 
 	// IconSelect is the icon used to identify the currently selected item in select mode.
-	IconSelect = Styler(FGBold)("▸")
+	IconSelect = Styler(FGBold)(glyphSelect)
+
+	// IconChecked is the icon used to identify a checked item in multi select mode.
+	IconChecked = Styler(FGGreen)(glyphChecked)
+
+	// IconUnchecked is the icon used to identify an unchecked item in multi select mode.
+	IconUnchecked = glyphUnchecked
+
+	// IconSpinner holds the frames of the spinner displayed while the items of a select are loading.
+	IconSpinner = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
 )
@@ -1,21 +1,41 @@
 package promptui
 
+// The glyphs of the default icons, displayed by themes with the styles of their IconStyles.
+const (
+	glyphInitial   = "?"
+	glyphGood      = "v"
+	glyphWarn      = "!"
+	glyphBad       = "x"
+	glyphSelect    = ">"
+	glyphChecked   = "[x]"
+	glyphUnchecked = "[ ]"
+)
+
 // These are the default icons used bu promptui for select and prompts. They can either be overridden directly
-// from these variable or customized through the use of custom templates
+// from these variable or customized through the use of a Theme or custom templates.
 var (
 	// IconInitial is the icon used when starting in prompt mode and the icon next to the label when
 	// starting in select mode.
-	IconInitial = Styler(FGBlue)("?")
+	IconInitial = Styler(FGBlue)(glyphInitial)
 
 	// IconGood is the icon used when a good answer is entered in prompt mode.
-	IconGood = Styler(FGGreen)("v")
+	IconGood = Styler(FGGreen)(glyphGood)
 
 	// IconWarn is the icon used when a good, but potentially invalid answer is entered in prompt mode.
-	IconWarn = Styler(FGYellow)("!")
+	IconWarn = Styler(FGYellow)(glyphWarn)
 
 	// IconBad is the icon used when a bad answer is entered in prompt mode.
-	IconBad = Styler(FGRed)("x")
+	IconBad = Styler(FGRed)(glyphBad)
 
 	// IconSelect is the icon used to identify the currently selected item in select mode.
-	IconSelect = Styler(FGBold)(">")
+	IconSelect = Styler(FGBold)(glyphSelect)
+
+	// IconChecked is the icon used to identify a checked item in multi select mode.
+	IconChecked = Styler(FGGreen)(glyphChecked)
+
+	// IconUnchecked is the icon used to identify an unchecked item in multi select mode.
+	IconUnchecked = glyphUnchecked
+
+	// IconSpinner holds the frames of the spinner displayed while the items of a select are loading.
+	IconSpinner = []string{"|", "/", "-", "\\"}
 )
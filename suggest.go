@@ -0,0 +1,61 @@
+package promptui
+
+import (
+	"strings"
+
+	"github.com/manifoldco/promptui/list"
+)
+
+// suggestSize is the number of suggestions displayed at once below a prompt.
+const suggestSize = 5
+
+// suggester keeps the candidates suggested for the input of a prompt and the one currently selected.
+type suggester struct {
+	suggest func(input string) []string
+	input   string
+	known   bool
+	list    *list.List
+}
+
+// update asks for the candidates matching input, unless they are already known. Candidates equal to the input
+// are left out as there is nothing left to complete.
+func (s *suggester) update(input string) {
+	if s.known && s.input == input {
+		return
+	}
+
+	s.input = input
+	s.known = true
+	s.list = nil
+
+	var candidates []string
+	for _, c := range s.suggest(input) {
+		if c != input {
+			candidates = append(candidates, c)
+		}
+	}
+
+	if len(candidates) > 0 {
+		s.list, _ = list.New(candidates, suggestSize)
+	}
+}
+
+// selected returns the candidate currently selected, if any.
+func (s *suggester) selected() (string, bool) {
+	if s.list == nil {
+		return "", false
+	}
+
+	items, idx := s.list.Items()
+	return items[idx].(string), true
+}
+
+// ghost returns the rest of the selected candidate when it starts with the input, to be displayed after it.
+func (s *suggester) ghost() string {
+	candidate, ok := s.selected()
+	if !ok || !strings.HasPrefix(candidate, s.input) {
+		return ""
+	}
+
+	return strings.TrimPrefix(candidate, s.input)
+}
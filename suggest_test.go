@@ -0,0 +1,115 @@
+package promptui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/manifoldco/promptui/promptuitest"
+)
+
+func fruits(input string) []string {
+	var result []string
+	for _, f := range []string{"apple", "apricot", "banana"} {
+		if strings.HasPrefix(f, input) {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+func TestPromptSuggest(t *testing.T) {
+	t.Run("accepts the selected suggestion", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type("ap", promptuitest.Down, promptuitest.Tab, promptuitest.Enter)
+
+		p := Prompt{
+			Label:   "Fruit",
+			Suggest: fruits,
+			Stdin:   term.Stdin(),
+			Stdout:  term.Stdout(),
+		}
+
+		result, err := p.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if result != "apricot" {
+			t.Errorf("expected %q, got %q", "apricot", result)
+		}
+
+		if lines := term.Lines(); len(lines) != 1 {
+			t.Errorf("expected the suggestions to be cleared, got %q", lines)
+		}
+	})
+
+	t.Run("keeps the input without suggestions", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type("kiwi", promptuitest.Down, promptuitest.Enter)
+
+		p := Prompt{
+			Label:   "Fruit",
+			Suggest: fruits,
+			Stdin:   term.Stdin(),
+			Stdout:  term.Stdout(),
+		}
+
+		result, err := p.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if result != "kiwi" {
+			t.Errorf("expected %q, got %q", "kiwi", result)
+		}
+	})
+
+	t.Run("displays the suggestions below the input", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type("ap")
+
+		p := Prompt{
+			Label:   "Fruit",
+			Suggest: fruits,
+			Templates: &PromptTemplates{
+				Valid:            "{{ . }}: ",
+				Suggestion:       "  {{ . }}",
+				ActiveSuggestion: "> {{ . }}",
+				Ghost:            "[{{ . }}]",
+			},
+			Stdin:  term.Stdin(),
+			Stdout: term.Stdout(),
+		}
+
+		_, err := p.Run()
+		if err != ErrEOF {
+			t.Fatalf("expected %v, got %v", ErrEOF, err)
+		}
+
+		output := term.Output()
+		for _, exp := range []string{"Fruit: ap█[ple]", "> apple", "  apricot"} {
+			if !strings.Contains(output, exp) {
+				t.Errorf("expected output to contain %q, got %q", exp, output)
+			}
+		}
+	})
+}
+
+func TestSuggester(t *testing.T) {
+	s := suggester{suggest: fruits}
+
+	s.update("ap")
+	if ghost := s.ghost(); ghost != "ple" {
+		t.Errorf("expected ghost %q, got %q", "ple", ghost)
+	}
+
+	s.list.Next()
+	if ghost := s.ghost(); ghost != "ricot" {
+		t.Errorf("expected ghost %q, got %q", "ricot", ghost)
+	}
+
+	s.update("apple")
+	if _, ok := s.selected(); ok {
+		t.Errorf("expected no suggestion for a complete input")
+	}
+}
@@ -0,0 +1,352 @@
+package promptui
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/manifoldco/promptui/validate"
+)
+
+// tagName is the name of the struct tags read by promptui.
+const tagName = "promptui"
+
+// tagKeys are the keys of the struct tags read by promptui.
+var tagKeys = map[string]bool{"label": true, "default": true, "validate": true, "options": true, "name": true}
+
+// Enum is implemented by types with a fixed set of values. Struct fields of such types are asked with a select
+// listing the values by Ask.
+type Enum interface {
+	// Values returns the values of the type, in the order they are listed.
+	Values() []interface{}
+}
+
+// enumType is the type of the Enum interface.
+var enumType = reflect.TypeOf((*Enum)(nil)).Elem()
+
+// TagValidators are the validation functions which can be named by the validate key of the struct tags read by
+// Ask, built with the validate package. More can be added before calling Ask.
+var TagValidators = map[string]ValidateFunc{
+	"required": validate.Required(),
+	"port":     validate.All(validate.Required(), validate.IntRange(1, 65535)),
+	"email":    validate.Email(),
+	"url":      validate.URL(),
+	"hostname": validate.Hostname(),
+	"ip":       validate.IP(),
+	"path":     validate.PathExists(),
+}
+
+// Ask prompts for each exported field of the struct pointed to by v and stores the answers in it. See FormFor
+// for how the fields are asked.
+func Ask(v interface{}) error {
+	return AskContext(context.Background(), v)
+}
+
+// AskContext prompts for the fields of a struct like Ask, but stops as soon as the given context is done.
+func AskContext(ctx context.Context, v interface{}) error {
+	form, err := FormFor(v)
+	if err != nil {
+		return err
+	}
+
+	return form.RunContext(ctx, v)
+}
+
+// FormFor returns a form asking for each exported field of the struct pointed to by v, in order. Fields are
+// configured with a promptui struct tag made of comma separated key=value pairs:
+//
+//	type Config struct {
+//		Host  string `promptui:"label=Host name,validate=required"`
+//		Port  int    `promptui:"label=Port,validate=port,default=8080"`
+//		Env   string `promptui:"options=dev|staging|prod"`
+//		Debug bool
+//		Token string `promptui:"-"`
+//	}
+//
+// The label key sets the label of the prompt, which defaults to the name of the field. The default key sets the
+// default answer, which defaults to the current value of the field when it is not the zero value. The validate key
+// names one of the TagValidators. The name key names the question, as with any Form. Fields tagged with "-" are
+// not asked. FormFor fails on unknown keys and validators, so that typos do not silently drop validation.
+//
+// Bool fields are asked with a confirm prompt. Fields with an options key, which lists the options separated by
+// pipes, and fields of a type implementing Enum are asked with a select, or a multi select for slices. Other
+// fields are asked with a prompt, the answer being converted to the type of the field. Answers which cannot be
+// converted are rejected with the ValidationError template of the prompt.
+func FormFor(v interface{}) (*Form, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%T is not a pointer to a struct", v)
+	}
+	rv = rv.Elem()
+
+	form := &Form{}
+
+	for i := 0; i < rv.NumField(); i++ {
+		sf := rv.Type().Field(i)
+		tag := sf.Tag.Get(tagName)
+		if sf.PkgPath != "" || tag == "-" {
+			continue
+		}
+
+		q, err := question(sf, rv.Field(i), parseTag(tag))
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %v", sf.Name, err)
+		}
+
+		form.Questions = append(form.Questions, q)
+	}
+
+	return form, nil
+}
+
+// question returns the question asking for a struct field.
+func question(sf reflect.StructField, field reflect.Value, opts map[string]string) (Question, error) {
+	q := Question{Name: fieldName(sf)}
+
+	for key := range opts {
+		if !tagKeys[key] {
+			return q, fmt.Errorf("unknown tag key %q", key)
+		}
+	}
+
+	label := sf.Name
+	if l, ok := opts["label"]; ok && l != "" {
+		label = l
+	}
+
+	def, ok := opts["default"]
+	if !ok && !isZero(field) {
+		def = fmt.Sprintf("%v", field.Interface())
+	}
+
+	var check ValidateFunc
+	if name, ok := opts["validate"]; ok {
+		check = TagValidators[name]
+		if check == nil {
+			return q, fmt.Errorf("unknown validator %q", name)
+		}
+	}
+
+	t := sf.Type
+	multi := t.Kind() == reflect.Slice
+	if multi {
+		t = t.Elem()
+	}
+
+	var items []interface{}
+	if options, ok := opts["options"]; ok {
+		for _, o := range strings.Split(options, "|") {
+			items = append(items, o)
+		}
+	} else {
+		items = enumValues(t)
+	}
+
+	switch {
+	case items != nil && multi:
+		ms := &MultiSelect{Label: label, Items: items}
+		for i, item := range items {
+			for j := 0; j < field.Len(); j++ {
+				if fmt.Sprintf("%v", item) == fmt.Sprintf("%v", field.Index(j).Interface()) {
+					ms.Checked = append(ms.Checked, i)
+				}
+			}
+		}
+		q.Prompt = ms
+	case items != nil:
+		s := &Select{Label: label, Items: items}
+		for i, item := range items {
+			if fmt.Sprintf("%v", item) == def {
+				s.CursorPos = i
+			}
+		}
+		q.Prompt = s
+	case multi:
+		return q, fmt.Errorf("%s needs options", sf.Type)
+	case t.Kind() == reflect.Bool:
+		p := &Prompt{Label: label, IsConfirm: true}
+		if b, err := strconv.ParseBool(def); err == nil && b {
+			p.Default = "y"
+		}
+		q.Prompt = p
+	default:
+		if !canParse(t) {
+			return q, fmt.Errorf("%s is not supported", t)
+		}
+
+		q.Prompt = &Prompt{
+			Label:   label,
+			Default: def,
+			Validate: func(input string) error {
+				_, err := parseValue(input, t)
+				if err != nil {
+					return err
+				}
+				if check != nil {
+					return check(input)
+				}
+				return nil
+			},
+		}
+	}
+
+	return q, nil
+}
+
+// enumValues returns the values of t when it implements Enum, with either value or pointer receivers, or nil
+// otherwise. Values is called on a new value rather than on a nil pointer.
+func enumValues(t reflect.Type) []interface{} {
+	var v reflect.Value
+	switch {
+	case t.Kind() == reflect.Ptr:
+		v = reflect.New(t.Elem())
+	case reflect.PtrTo(t).Implements(enumType):
+		v = reflect.New(t)
+	default:
+		return nil
+	}
+
+	if enum, ok := v.Interface().(Enum); ok {
+		return enum.Values()
+	}
+	return nil
+}
+
+// isZero returns whether v is the zero value of its type.
+func isZero(v reflect.Value) bool {
+	return reflect.DeepEqual(v.Interface(), reflect.Zero(v.Type()).Interface())
+}
+
+// parseTag parses a promptui struct tag made of comma separated key=value pairs. A key without a value is
+// mapped to an empty string.
+func parseTag(tag string) map[string]string {
+	opts := make(map[string]string)
+
+	for _, pair := range strings.Split(tag, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		kv := strings.SplitN(pair, "=", 2)
+		key := strings.TrimSpace(kv[0])
+		if len(kv) == 1 {
+			opts[key] = ""
+			continue
+		}
+		opts[key] = strings.TrimSpace(kv[1])
+	}
+
+	return opts
+}
+
+// fieldName returns the name identifying a struct field, given by the name key of its tag or its own name.
+func fieldName(f reflect.StructField) string {
+	if name, ok := parseTag(f.Tag.Get(tagName))["name"]; ok && name != "" {
+		return name
+	}
+	return f.Name
+}
+
+// canParse returns whether parseValue can convert text to a value of type t.
+func canParse(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// parseValue converts text to a value of type t.
+func parseValue(text string, t reflect.Type) (reflect.Value, error) {
+	v := reflect.New(t).Elem()
+
+	if t == reflect.TypeOf(time.Duration(0)) {
+		d, err := time.ParseDuration(text)
+		if err != nil {
+			return v, fmt.Errorf("%q is not a duration", text)
+		}
+		v.SetInt(int64(d))
+		return v, nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		v.SetString(text)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return v, fmt.Errorf("%q is not a boolean", text)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(text, 10, t.Bits())
+		if err != nil {
+			return v, fmt.Errorf("%q is not an integer", text)
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(text, 10, t.Bits())
+		if err != nil {
+			return v, fmt.Errorf("%q is not a positive integer", text)
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(text, t.Bits())
+		if err != nil {
+			return v, fmt.Errorf("%q is not a number", text)
+		}
+		v.SetFloat(f)
+	default:
+		return v, fmt.Errorf("cannot convert %q to %s", text, t)
+	}
+
+	return v, nil
+}
+
+// assign sets field to value, converting it to the type of the field when needed.
+func assign(field reflect.Value, value interface{}) error {
+	v := reflect.ValueOf(value)
+	t := field.Type()
+
+	switch {
+	case !v.IsValid():
+		field.Set(reflect.Zero(t))
+	case v.Type().AssignableTo(t):
+		field.Set(v)
+	case v.Kind() == t.Kind() && v.Type().ConvertibleTo(t):
+		field.Set(v.Convert(t))
+	case v.Kind() == reflect.Slice && t.Kind() == reflect.Slice:
+		s := reflect.MakeSlice(t, v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			err := assign(s.Index(i), v.Index(i).Interface())
+			if err != nil {
+				return err
+			}
+		}
+		field.Set(s)
+	case t.Kind() == reflect.Ptr:
+		p := reflect.New(t.Elem())
+		err := assign(p.Elem(), value)
+		if err != nil {
+			return err
+		}
+		field.Set(p)
+	case v.Kind() == reflect.String:
+		parsed, err := parseValue(v.String(), t)
+		if err != nil {
+			return err
+		}
+		field.Set(parsed)
+	case t.Kind() == reflect.String:
+		field.SetString(fmt.Sprintf("%v", value))
+	default:
+		return fmt.Errorf("cannot assign %s to %s", v.Type(), t)
+	}
+
+	return nil
+}
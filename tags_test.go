@@ -0,0 +1,189 @@
+package promptui
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/manifoldco/promptui/promptuitest"
+)
+
+func TestParseTag(t *testing.T) {
+	opts := parseTag("label=Port, default=8080,required,")
+
+	exp := map[string]string{"label": "Port", "default": "8080", "required": ""}
+	if !reflect.DeepEqual(opts, exp) {
+		t.Errorf("expected %v, got %v", exp, opts)
+	}
+}
+
+type env int
+
+const (
+	dev env = iota
+	staging
+	prod
+)
+
+func (e env) Values() []interface{} {
+	return []interface{}{dev, staging, prod}
+}
+
+func (e env) String() string {
+	return [...]string{"dev", "staging", "prod"}[e]
+}
+
+// tier implements Enum with a pointer receiver reading the tier, which panics on a nil pointer.
+type tier string
+
+func (t *tier) Values() []interface{} {
+	return []interface{}{*t + "free", *t + "pro"}
+}
+
+type config struct {
+	Host    string        `promptui:"label=Host name,validate=required"`
+	Port    int           `promptui:"validate=port,default=8080"`
+	Env     env           `promptui:"name=environment"`
+	Region  string        `promptui:"options=eu|us|ap,default=us"`
+	Tags    []string      `promptui:"options=web|db|cache"`
+	Timeout time.Duration `promptui:"default=5s"`
+	Debug   bool
+	Token   string `promptui:"-"`
+	secret  string
+}
+
+func TestTagValidators(t *testing.T) {
+	port := TagValidators["port"]
+
+	for _, in := range []string{"", "0", "65536", "http"} {
+		if err := port(in); err == nil {
+			t.Errorf("expected port %q to be invalid", in)
+		}
+	}
+
+	if err := port("8080"); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestFormFor(t *testing.T) {
+	t.Run("asks for each field", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type("db1", promptuitest.Enter, promptuitest.Enter, promptuitest.Down, promptuitest.Enter,
+			promptuitest.Down, promptuitest.Enter, promptuitest.Down, promptuitest.Space, promptuitest.Enter,
+			promptuitest.Enter, "y", promptuitest.Enter)
+
+		c := config{Token: "t0k3n"}
+
+		form, err := FormFor(&c)
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		form.Stdin = term.Stdin()
+		form.Stdout = term.Stdout()
+
+		err = form.Run(&c)
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		exp := config{Host: "db1", Port: 8080, Env: staging, Region: "ap", Tags: []string{"db"},
+			Timeout: 5 * time.Second, Debug: true, Token: "t0k3n"}
+		if !reflect.DeepEqual(c, exp) {
+			t.Errorf("expected %+v, got %+v", exp, c)
+		}
+	})
+
+	t.Run("rejects values of the wrong type", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type("db1", promptuitest.Enter, promptuitest.Backspace, promptuitest.Backspace, promptuitest.Backspace,
+			promptuitest.Backspace, "http", promptuitest.Enter)
+
+		var c config
+
+		form, err := FormFor(&c)
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		form.Stdin = term.Stdin()
+		form.Stdout = term.Stdout()
+
+		err = form.Run(&c)
+		if err != ErrEOF {
+			t.Fatalf("expected %v, got %v", ErrEOF, err)
+		}
+
+		if !strings.Contains(term.Output(), `"http" is not an integer`) {
+			t.Errorf("expected the conversion error to be displayed, got %q", term.Output())
+		}
+	})
+
+	t.Run("asks for enums with pointer receivers", func(t *testing.T) {
+		var v struct {
+			Tier    tier
+			Upgrade *tier
+		}
+
+		form, err := FormFor(&v)
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		for _, q := range form.Questions {
+			s, ok := q.Prompt.(*Select)
+			if !ok {
+				t.Errorf("expected a select for %s, got %T", q.Name, q.Prompt)
+				continue
+			}
+			if exp := []interface{}{tier("free"), tier("pro")}; !reflect.DeepEqual(s.Items, exp) {
+				t.Errorf("expected the values of the enum for %s, got %v", q.Name, s.Items)
+			}
+		}
+
+		term := promptuitest.New()
+		term.Type(promptuitest.Enter, promptuitest.Down, promptuitest.Enter)
+		form.Stdin = term.Stdin()
+		form.Stdout = term.Stdout()
+
+		err = form.Run(&v)
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if v.Tier != "free" || v.Upgrade == nil || *v.Upgrade != "pro" {
+			t.Errorf("expected the chosen tiers, got %q and %v", v.Tier, v.Upgrade)
+		}
+	})
+
+	t.Run("fails on unknown tag keys and validators", func(t *testing.T) {
+		var v struct {
+			Port int `promptui:"valdiate=port"`
+		}
+
+		_, err := FormFor(&v)
+		if err == nil || !strings.Contains(err.Error(), `unknown tag key "valdiate"`) {
+			t.Errorf("expected an unknown key error, got %v", err)
+		}
+
+		var w struct {
+			Port int `promptui:"validate=prot"`
+		}
+
+		_, err = FormFor(&w)
+		if err == nil || !strings.Contains(err.Error(), `unknown validator "prot"`) {
+			t.Errorf("expected an unknown validator error, got %v", err)
+		}
+	})
+
+	t.Run("fails on unsupported fields", func(t *testing.T) {
+		var v struct {
+			Created time.Time
+		}
+
+		_, err := FormFor(&v)
+		if err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+}
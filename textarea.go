@@ -0,0 +1,327 @@
+package promptui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"unicode"
+
+	"github.com/chzyer/readline"
+	"github.com/manifoldco/promptui/screenbuf"
+)
+
+// TextArea represents a multi-line text field input, for values such as commit messages, descriptions or
+// snippets of configuration. Enter starts a new line, the arrow keys move the cursor across lines and the text
+// is submitted with the SubmitKey.
+type TextArea struct {
+	// Label is the value displayed on the command line prompt.
+	//
+	// The value for Label can be a simple string or a struct that will need to be accessed by dot notation
+	// inside the templates. For example, `{{ .Name }}` will display the name property of a struct.
+	Label interface{}
+
+	// ID identifies the text area when looking up preset answers. When the Answers provider has an answer for
+	// the ID, Run returns it without asking the user. See the AnswerProvider docs for more info.
+	ID string
+
+	// Default is the initial value of the text area. It can span several lines.
+	Default string
+
+	// Validate is an optional function that will be used against the whole text to validate it.
+	Validate ValidateFunc
+
+	// MaxLines is the maximum number of lines of the text. Defaults to 0, which means there is no limit.
+	MaxLines int
+
+	// MaxLength is the maximum number of characters of the text, including line breaks. Defaults to 0, which
+	// means there is no limit.
+	MaxLength int
+
+	// SubmitKey is the key used to submit the text. Defaults to ctrl+d.
+	SubmitKey Key
+
+	// HideEntered sets whether to hide the text after the user has submitted it.
+	HideEntered bool
+
+	// Templates can be used to customize the text area output. If nil is passed, the default templates are used.
+	// The Prompt, Valid and Invalid templates render the label line displayed above the text while the
+	// Success template renders it once the text is submitted. See the PromptTemplates docs for more info.
+	Templates *PromptTemplates
+
+	// Theme sets the icons, styles and default templates of the text area. It defaults to DefaultTheme. See the
+	// Theme docs for more info.
+	Theme *Theme
+
+	// ColorProfile limits the styles displayed by the text area, the ones beyond it being downgraded or stripped.
+	// It defaults to DefaultColorProfile, or to the profile detected from Stdout.
+	ColorProfile ColorProfile
+
+	// Accessible runs the text area in accessible mode, reading plain text lines until an empty one instead of
+	// redrawing its output. It is enabled for all of them by the Accessible variable.
+	Accessible bool
+
+	// the Pointer defines how to render the cursor.
+	Pointer Pointer
+
+	Stdin  io.ReadCloser
+	Stdout io.WriteCloser
+}
+
+// Run executes the text area. It displays the label and the default value if any, letting the user edit the text
+// until it is submitted with a valid value or the text area is canceled from the command prompt. It returns the
+// text with lines separated by \n and an error if any occurred during the text area's execution.
+func (ta *TextArea) Run() (string, error) {
+	return ta.RunContext(context.Background())
+}
+
+// RunContext executes the text area like Run, but stops it as soon as the given context is done. When that
+// happens, the text area is cleared from the terminal and the context's error is returned.
+func (ta *TextArea) RunContext(ctx context.Context) (string, error) {
+	err := ta.prepareTemplates()
+	if err != nil {
+		return "", err
+	}
+
+	if ta.SubmitKey.Code == 0 {
+		ta.SubmitKey = Key{Code: readline.CharDelete, Display: "ctrl+d"}
+	}
+
+	if answer, ok := presetAnswer(ta.ID); ok {
+		return ta.answer(answer)
+	}
+
+	if ta.Accessible || Accessible {
+		return ta.runAccessible(ctx)
+	}
+
+	if !isInteractive(ta.Stdin) {
+		return ta.runLine()
+	}
+
+	c := &readline.Config{
+		Stdin:          ta.Stdin,
+		Stdout:         ta.Stdout,
+		HistoryLimit:   -1,
+		UniqueEditLine: true,
+	}
+
+	err = c.Init()
+	if err != nil {
+		return "", err
+	}
+
+	stdin := readline.NewCancelableStdin(c.Stdin)
+	c.Stdin = stdin
+
+	validFn := func(x string) error {
+		return nil
+	}
+	if ta.Validate != nil {
+		validFn = ta.Validate
+	}
+
+	var inputErr error
+	cur := NewMultilineCursor(ta.Default, ta.Pointer)
+
+	var sb *screenbuf.ScreenBuf
+	muted := resolveTheme(ta.Theme).Styles.Muted
+
+	draw := func() {
+		label := render(ta.Templates.valid, ta.Label)
+		if validFn(cur.Get()) != nil {
+			label = render(ta.Templates.invalid, ta.Label)
+		}
+		label = append(label, muted(fmt.Sprintf("(%s to submit)", ta.SubmitKey.Display))...)
+
+		sb.SetWidth(terminalWidth(ta.Stdout))
+		sb.Reset()
+		sb.Write(label)
+		for _, l := range cur.Format() {
+			sb.WriteString(l)
+		}
+		if inputErr != nil {
+			sb.Write(render(ta.Templates.validation, inputErr))
+			inputErr = nil
+		}
+		sb.Flush()
+	}
+
+	var rl *readline.Instance
+
+	// Every key is handled before readline processes it, since readline only knows about single lines. The
+	// submit key is turned into enter so that readline returns.
+	c.FuncFilterInputRune = func(key rune) (rune, bool) {
+		switch key {
+		case ta.SubmitKey.Code:
+			return readline.CharEnter, true
+		case 0, readline.CharInterrupt:
+			// 0 is sent by readline when the input is exhausted.
+			return key, true
+		case KeyEnter, readline.CharCtrlJ:
+			if ta.MaxLines == 0 || cur.LineCount() < ta.MaxLines {
+				if ta.MaxLength == 0 || cur.Len() < ta.MaxLength {
+					cur.Newline()
+				}
+			}
+		case KeyBackspace, KeyCtrlH:
+			cur.Backspace()
+		case KeyPrev:
+			cur.MoveLine(-1)
+		case KeyNext:
+			cur.MoveLine(1)
+		case KeyBackward:
+			cur.Move(-1)
+		case KeyForward:
+			cur.Move(1)
+		case readline.CharLineStart:
+			cur.LineStart()
+		case readline.CharLineEnd:
+			cur.LineEnd()
+		default:
+			if unicode.IsPrint(key) && (ta.MaxLength == 0 || cur.Len() < ta.MaxLength) {
+				cur.Update(string(key))
+			}
+		}
+
+		// readline's terminal waits for the next read after these keys, which never comes since they are
+		// swallowed here.
+		switch key {
+		case KeyEnter, readline.CharCtrlJ, readline.CharDelete, readline.CharInterrupt:
+			rl.Terminal.KickRead()
+		}
+
+		draw()
+		return key, false
+	}
+
+	// The listener only draws the text area when readline starts reading, every key press being handled by the
+	// filter above.
+	c.SetListener(func(line []rune, pos int, key rune) ([]rune, int, bool) {
+		if key == 0 {
+			draw()
+		}
+		return nil, 0, false
+	})
+
+	rl, err = readline.NewEx(c)
+	if err != nil {
+		return "", err
+	}
+
+	rl.Write([]byte(hideCursor))
+	sb = screenbuf.New(newProfileWriter(rl, ta.ColorProfile, ta.Stdout))
+
+	stop := cancelOnDone(ctx, stdin)
+	defer stop()
+
+	for {
+		_, err = rl.Readline()
+		if err != nil {
+			break
+		}
+
+		inputErr = validFn(cur.Get())
+		if inputErr == nil {
+			break
+		}
+	}
+
+	if err != nil {
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		} else if err == readline.ErrInterrupt || err.Error() == "Interrupt" {
+			err = ErrInterrupt
+		} else if err == io.EOF {
+			err = ErrEOF
+		}
+
+		clearScreen(sb)
+		rl.Write([]byte(showCursor))
+		rl.Close()
+		return "", err
+	}
+
+	if ta.HideEntered {
+		clearScreen(sb)
+	} else {
+		sb.SetWidth(terminalWidth(ta.Stdout))
+		sb.Reset()
+		sb.Write(render(ta.Templates.success, ta.Label))
+		for _, l := range strings.Split(cur.Get(), "\n") {
+			sb.WriteString(l)
+		}
+		sb.Flush()
+	}
+
+	rl.Write([]byte(showCursor))
+	rl.Close()
+
+	return cur.Get(), nil
+}
+
+// runLine reads the text from stdin until it is exhausted, since the end of the text cannot be told apart from
+// a line break without a terminal.
+func (ta *TextArea) runLine() (string, error) {
+	in, out := lineStreams(ta.Stdin, ta.Stdout)
+
+	fmt.Fprintf(out, "%v:\n", ta.Label)
+
+	b, err := ioutil.ReadAll(in)
+	if err != nil {
+		return "", err
+	}
+
+	if len(b) == 0 && ta.Default == "" {
+		return "", ErrNoInput
+	}
+
+	return ta.answer(strings.TrimSuffix(strings.Replace(string(b), "\r\n", "\n", -1), "\n"))
+}
+
+// answer checks a text given without the interactive text area, returning it if it is valid.
+func (ta *TextArea) answer(answer string) (string, error) {
+	if answer == "" {
+		answer = ta.Default
+	}
+
+	if ta.MaxLength > 0 && len([]rune(answer)) > ta.MaxLength {
+		return "", fmt.Errorf("text is longer than %d characters", ta.MaxLength)
+	}
+
+	if ta.MaxLines > 0 && strings.Count(answer, "\n") >= ta.MaxLines {
+		return "", fmt.Errorf("text has more than %d lines", ta.MaxLines)
+	}
+
+	if ta.Validate != nil {
+		if err := ta.Validate(answer); err != nil {
+			return "", err
+		}
+	}
+
+	return answer, nil
+}
+
+func (ta *TextArea) prepareTemplates() error {
+	p := Prompt{Templates: ta.Templates, Theme: ta.Theme}
+
+	tpls := ta.Templates
+	if tpls == nil {
+		tpls = &PromptTemplates{}
+		p.Templates = tpls
+	}
+
+	if tpls.Success == "" {
+		tpls.Success = fmt.Sprintf("{{ . | muted }}%s", resolveTheme(ta.Theme).Styles.Muted(":"))
+	}
+
+	err := p.prepareTemplates()
+	if err != nil {
+		return err
+	}
+
+	ta.Templates = p.Templates
+
+	return nil
+}
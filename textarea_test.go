@@ -0,0 +1,142 @@
+package promptui
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/manifoldco/promptui/promptuitest"
+)
+
+func TestTextArea(t *testing.T) {
+	t.Run("submits lines with the submit key", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type("first", promptuitest.Enter, "third", promptuitest.Up, promptuitest.Enter, "second",
+			promptuitest.CtrlD)
+
+		ta := TextArea{
+			Label:  "Message",
+			Stdin:  term.Stdin(),
+			Stdout: term.Stdout(),
+		}
+
+		result, err := ta.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		exp := "first\nsecond\nthird"
+		if result != exp {
+			t.Errorf("expected %q, got %q", exp, result)
+		}
+
+		lines := term.Lines()
+		if len(lines) < 4 || lines[1] != "first" || lines[3] != "third" {
+			t.Errorf("expected the text below the label, got %q", lines)
+		}
+	})
+
+	t.Run("uses its theme and color profile", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type("hi", promptuitest.CtrlD)
+
+		ta := TextArea{
+			Label:        "Message",
+			Theme:        ASCIITheme,
+			ColorProfile: NoColor,
+			Stdin:        term.Stdin(),
+			Stdout:       term.Stdout(),
+		}
+
+		_, err := ta.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		out := term.Output()
+		if !strings.Contains(out, "v Message: (ctrl+d to submit)") {
+			t.Errorf("expected the icon of the theme, got %q", out)
+		}
+		if strings.Contains(out, "\x1b[0m") {
+			t.Errorf("expected no styles in the output, got %q", out)
+		}
+	})
+
+	t.Run("enforces limits", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type("a", promptuitest.Enter, "b", promptuitest.Enter, "cdef", promptuitest.CtrlD)
+
+		ta := TextArea{
+			Label:     "Message",
+			MaxLines:  2,
+			MaxLength: 5,
+			Stdin:     term.Stdin(),
+			Stdout:    term.Stdout(),
+		}
+
+		result, err := ta.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if result != "a\nbcd" {
+			t.Errorf("expected %q, got %q", "a\nbcd", result)
+		}
+	})
+
+	t.Run("validates the whole text", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type("a", promptuitest.CtrlD, promptuitest.Enter, "b", promptuitest.CtrlD)
+
+		ta := TextArea{
+			Label: "Message",
+			Validate: func(input string) error {
+				if !strings.Contains(input, "\n") {
+					return errors.New("two lines needed")
+				}
+				return nil
+			},
+			Stdin:  term.Stdin(),
+			Stdout: term.Stdout(),
+		}
+
+		result, err := ta.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if result != "a\nb" {
+			t.Errorf("expected %q, got %q", "a\nb", result)
+		}
+
+		if !strings.Contains(term.Output(), "two lines needed") {
+			t.Errorf("expected the validation error to be displayed")
+		}
+	})
+}
+
+func TestTextAreaWrap(t *testing.T) {
+	term := promptuitest.NewWithWidth(20)
+	term.Type("a line much wider than the terminal", strings.Repeat(promptuitest.Backspace, 24), promptuitest.CtrlD)
+
+	ta := TextArea{
+		Label:  "Message",
+		Stdin:  term.Stdin(),
+		Stdout: term.Stdout(),
+	}
+
+	result, err := ta.Run()
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if result != "a line much" {
+		t.Errorf("expected %q, got %q", "a line much", result)
+	}
+
+	exp := []string{"Message:", "a line much"}
+	if got := term.Lines(); !reflect.DeepEqual(got, exp) {
+		t.Errorf("expected no stale rows, got %q", got)
+	}
+}
@@ -0,0 +1,316 @@
+package promptui
+
+import (
+	"fmt"
+	"text/template"
+)
+
+// Theme defines the look of prompts and selects: the icons they display, the styles applied to each role of their
+// text and their default templates. A theme can be set for the whole package with DefaultTheme, or for a single
+// prompt or select with its Theme field. Templates set on a prompt or a select always take precedence over the
+// ones of its theme.
+type Theme struct {
+	// Icons are the icons displayed by the default templates. Empty icons default to the glyphs of the Icon
+	// variables.
+	Icons Icons
+
+	// IconStyles are the styles applied to the icons when prompts and selects are rendered. Empty icons get the
+	// default style of the icon unless a style is set.
+	IconStyles IconStyles
+
+	// Styles are the styles applied to each role of the text by the default templates. See the Styles docs for
+	// the template functions applying them in custom templates.
+	Styles Styles
+
+	// Prompt holds the default templates of prompts, including confirm prompts. Empty templates are built from the
+	// icons and styles of the theme.
+	Prompt PromptTemplates
+
+	// Select holds the default templates of selects, which multi selects share except for the templates held by
+	// MultiSelect. Empty templates are built from the icons and styles of the theme.
+	Select SelectTemplates
+
+	// MultiSelect holds the default Selected and Help templates of multi selects. Empty templates are built from
+	// the icons and styles of the theme.
+	MultiSelect SelectTemplates
+}
+
+// Icons are the icons displayed by prompts and selects. See the Icon variables for the meaning of each icon.
+type Icons struct {
+	Initial   string
+	Good      string
+	Warn      string
+	Bad       string
+	Select    string
+	Checked   string
+	Unchecked string
+	Spinner   []string
+}
+
+// IconStyles are the styles applied to each icon of a theme, as returned by Styler.
+type IconStyles struct {
+	// Initial styles the Initial icon. It defaults to blue.
+	Initial func(interface{}) string
+
+	// Good styles the Good icon. It defaults to green.
+	Good func(interface{}) string
+
+	// Warn styles the Warn icon. It defaults to yellow.
+	Warn func(interface{}) string
+
+	// Bad styles the Bad icon. It defaults to red.
+	Bad func(interface{}) string
+
+	// Select styles the Select icon. It defaults to bold.
+	Select func(interface{}) string
+
+	// Checked styles the Checked icon. It defaults to green.
+	Checked func(interface{}) string
+
+	// Unchecked styles the Unchecked icon. It defaults to no style.
+	Unchecked func(interface{}) string
+}
+
+// Styles are the styles applied to each role of the text of prompts and selects, as returned by Styler. Nil
+// styles use the default ones.
+//
+// Each style but Highlight is also available to all templates as a function named after its role, so that custom
+// templates can follow the theme:
+//
+//	{{ . | label }} {{ "(optional)" | muted }}
+type Styles struct {
+	// Label styles the labels of prompts. It defaults to bold.
+	Label func(interface{}) string
+
+	// Accent styles the text drawing attention, such as the spinner of loading selects. It defaults to cyan.
+	Accent func(interface{}) string
+
+	// Success styles the text of successful actions, such as the selected item. It defaults to green.
+	Success func(interface{}) string
+
+	// Warning styles validation warnings. It defaults to yellow.
+	Warning func(interface{}) string
+
+	// Error styles validation errors and failures. It defaults to red.
+	Error func(interface{}) string
+
+	// Muted styles secondary text, such as help messages and submitted answers. It defaults to faint.
+	Muted func(interface{}) string
+
+	// Active styles the active item of selects and suggestions. It defaults to underline.
+	Active func(interface{}) string
+
+	// Highlight styles the runes of searched items matching the search term, through the highlight function of
+	// select templates. It defaults to bold cyan.
+	Highlight func(interface{}) string
+}
+
+// DefaultTheme is the theme of the prompts and selects which do not set their own. It can be replaced to change
+// the look of all of them, for example with one of the built-in themes.
+var DefaultTheme = &Theme{}
+
+// ClassicTheme is the built-in theme with the default look of promptui.
+var ClassicTheme = &Theme{}
+
+// ASCIITheme is a built-in theme using ASCII icons, for terminals or fonts missing the default ones.
+var ASCIITheme = &Theme{
+	Icons: Icons{
+		Good:      "v",
+		Warn:      "!",
+		Bad:       "x",
+		Select:    ">",
+		Checked:   "[x]",
+		Unchecked: "[ ]",
+		Spinner:   []string{"|", "/", "-", "\\"},
+	},
+	IconStyles: IconStyles{
+		Good:    Styler(FGGreen),
+		Warn:    Styler(FGYellow),
+		Bad:     Styler(FGRed),
+		Select:  Styler(FGBold),
+		Checked: Styler(FGGreen),
+	},
+}
+
+// MinimalTheme is a built-in theme without colors, relying on plain icons and text states only.
+var MinimalTheme = &Theme{
+	Icons: Icons{
+		Initial:   "?",
+		Good:      "✔",
+		Warn:      "!",
+		Bad:       "✗",
+		Select:    ">",
+		Checked:   "[x]",
+		Unchecked: "[ ]",
+	},
+	Styles: Styles{
+		Accent:    Styler(FGBold),
+		Success:   Styler(FGBold),
+		Warning:   Styler(FGBold),
+		Error:     Styler(FGBold),
+		Highlight: Styler(FGBold),
+	},
+}
+
+// styled returns the icons with the given styles applied. Empty icons get the glyphs of the default icons, with
+// the default style of the icon unless a style is set, or the Icon variables as is when they were overridden.
+func (i Icons) styled(s IconStyles) Icons {
+	i.Initial = styleIcon(i.Initial, IconInitial, glyphInitial, s.Initial, Styler(FGBlue))
+	i.Good = styleIcon(i.Good, IconGood, glyphGood, s.Good, Styler(FGGreen))
+	i.Warn = styleIcon(i.Warn, IconWarn, glyphWarn, s.Warn, Styler(FGYellow))
+	i.Bad = styleIcon(i.Bad, IconBad, glyphBad, s.Bad, Styler(FGRed))
+	i.Select = styleIcon(i.Select, IconSelect, glyphSelect, s.Select, Styler(FGBold))
+	i.Checked = styleIcon(i.Checked, IconChecked, glyphChecked, s.Checked, Styler(FGGreen))
+	i.Unchecked = styleIcon(i.Unchecked, IconUnchecked, glyphUnchecked, s.Unchecked, nil)
+	if len(i.Spinner) == 0 {
+		i.Spinner = IconSpinner
+	}
+	return i
+}
+
+// styleIcon applies style to icon. An empty icon defaults to glyph styled with defStyle when style is nil, unless
+// the Icon variable holding it was overridden, in which case the variable is used as it was set.
+func styleIcon(icon, variable, glyph string, style, defStyle func(interface{}) string) string {
+	if icon == "" {
+		switch {
+		case variable != applyStyle(defStyle, glyph):
+			icon = variable
+		case style == nil:
+			icon, style = glyph, defStyle
+		default:
+			icon = glyph
+		}
+	}
+
+	return applyStyle(style, icon)
+}
+
+// applyStyle applies style to s, or returns s as is when style is nil.
+func applyStyle(style func(interface{}) string, s string) string {
+	if style == nil {
+		return s
+	}
+	return style(s)
+}
+
+// withDefaults returns the styles with the nil ones set to the default styles.
+func (s Styles) withDefaults() Styles {
+	if s.Label == nil {
+		s.Label = Styler(FGBold)
+	}
+	if s.Accent == nil {
+		s.Accent = Styler(FGCyan)
+	}
+	if s.Success == nil {
+		s.Success = Styler(FGGreen)
+	}
+	if s.Warning == nil {
+		s.Warning = Styler(FGYellow)
+	}
+	if s.Error == nil {
+		s.Error = Styler(FGRed)
+	}
+	if s.Muted == nil {
+		s.Muted = Styler(FGFaint)
+	}
+	if s.Active == nil {
+		s.Active = Styler(FGUnderline)
+	}
+	if s.Highlight == nil {
+		s.Highlight = Styler(FGBold, FGCyan)
+	}
+	return s
+}
+
+// funcs returns the template functions applying the styles, named after their roles.
+func (s Styles) funcs() template.FuncMap {
+	return template.FuncMap{
+		"label":   s.Label,
+		"accent":  s.Accent,
+		"success": s.Success,
+		"warning": s.Warning,
+		"error":   s.Error,
+		"muted":   s.Muted,
+		"active":  s.Active,
+	}
+}
+
+// resolveTheme returns a copy of t, or of DefaultTheme when t is nil, with its icons, styles and empty templates
+// set to their defaults. The Confirm template is left empty when unset, as its default depends on the prompt.
+func resolveTheme(t *Theme) *Theme {
+	if t == nil {
+		t = DefaultTheme
+	}
+	if t == nil {
+		t = &Theme{}
+	}
+
+	th := *t
+	th.Icons = th.Icons.styled(th.IconStyles)
+	th.Styles = th.Styles.withDefaults()
+
+	icons, styles := th.Icons, th.Styles
+	label, muted := styles.Label, styles.Muted
+
+	pt := &th.Prompt
+	setDefault(&pt.Prompt, fmt.Sprintf("%s {{ . | label }}%s ", label(icons.Initial), label(":")))
+	setDefault(&pt.Valid, fmt.Sprintf("%s {{ . | label }}%s ", label(icons.Good), label(":")))
+	setDefault(&pt.Invalid, fmt.Sprintf("%s {{ . | label }}%s ", label(icons.Bad), label(":")))
+	setDefault(&pt.Pending, fmt.Sprintf("%s {{ . | label }}%s ", label(icons.Warn), label(":")))
+	setDefault(&pt.ValidationError, `{{ ">>" | error }} {{ . | error }}`)
+	setDefault(&pt.Warning, fmt.Sprintf("%s {{ . | warning }}", icons.Warn))
+	setDefault(&pt.Success, fmt.Sprintf("{{ . | muted }}%s ", muted(":")))
+	setDefault(&pt.Suggestion, "  {{ . }}")
+	setDefault(&pt.ActiveSuggestion, fmt.Sprintf("%s {{ . | active }}", icons.Select))
+	setDefault(&pt.Ghost, "{{ . | muted }}")
+	setDefault(&pt.HistorySearch,
+		`{{ if .Failed }}{{ "failing " | error }}{{ end }}{{ "reverse-i-search:" | muted }} {{ .Term }}`)
+
+	st := &th.Select
+	setDefault(&st.Label, fmt.Sprintf("%s {{.}}: ", icons.Initial))
+	setDefault(&st.Active, fmt.Sprintf("%s {{ . | active }}", icons.Select))
+	setDefault(&st.Inactive, "  {{.}}")
+	setDefault(&st.Selected, fmt.Sprintf(`{{ %q | success }} {{ . | muted }}`, icons.Good))
+	setDefault(&st.Help, `{{ "Use the arrow keys to navigate:" | muted }} {{ .NextKey | muted }} `+
+		`{{ .PrevKey | muted }} {{ .PageDownKey | muted }} {{ .PageUpKey | muted }} `+
+		`{{ if .Search }} {{ "and" | muted }} {{ .SearchKey | muted }} {{ "toggles search" | muted }}{{ end }}`)
+	setDefault(&st.ValidationError, `{{ ">>" | error }} {{ . | error }}`)
+	setDefault(&st.Loading, `{{ .Spinner | accent }} {{ "Loading" | muted }} {{ .Count | muted }} {{ "items" | muted }}`)
+	setDefault(&st.Error, fmt.Sprintf(`%s {{ . | error }}`, icons.Bad))
+
+	mt := &th.MultiSelect
+	setDefault(&mt.Selected, fmt.Sprintf(`{{ %q | success }} `+
+		`{{ range $i, $e := . }}{{ if $i }}{{ ", " | muted }}{{ end }}{{ $e | muted }}{{ end }}`, icons.Good))
+	setDefault(&mt.Help, `{{ "Use the arrow keys to navigate:" | muted }} {{ .NextKey | muted }} `+
+		`{{ .PrevKey | muted }} {{ .PageDownKey | muted }} {{ .PageUpKey | muted }}{{ ", " | muted }}`+
+		`{{ .ToggleKey | muted }} {{ "toggles" | muted }}`+
+		`{{ if .Search }} {{ "and" | muted }} {{ .SearchKey | muted }} {{ "toggles search" | muted }}{{ end }}`)
+
+	return &th
+}
+
+// confirm returns the default Confirm template of a prompt, showing hint as the expected answers.
+func (t *Theme) confirm(hint string) string {
+	if t.Prompt.Confirm != "" {
+		return t.Prompt.Confirm
+	}
+
+	return fmt.Sprintf(`{{ %q | label }} {{ . | label }}? {{ "[%s]" | muted }} `, t.Icons.Initial, hint)
+}
+
+// funcs returns the template functions of templates rendered with the theme, which are the functions of the
+// styles along with the ones of funcs, taking precedence.
+func (t *Theme) funcs(funcs template.FuncMap) template.FuncMap {
+	all := t.Styles.funcs()
+	for name, fn := range funcs {
+		all[name] = fn
+	}
+	return all
+}
+
+// setDefault sets tpl to def if it is empty.
+func setDefault(tpl *string, def string) {
+	if *tpl == "" {
+		*tpl = def
+	}
+}
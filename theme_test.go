@@ -0,0 +1,139 @@
+package promptui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/manifoldco/promptui/promptuitest"
+)
+
+func TestTheme(t *testing.T) {
+	t.Run("uses the icons and styles of the theme", func(t *testing.T) {
+		s := Select{Label: "Number", Items: []string{"Zero"}, Theme: ASCIITheme}
+
+		err := s.prepareTemplates()
+		if err != nil {
+			t.Fatalf("Unexpected error preparing templates %v", err)
+		}
+
+		result := string(render(s.Templates.active, "Zero"))
+		exp := "\x1b[1m>\x1b[0m \x1b[4mZero\x1b[0m"
+		if result != exp {
+			t.Errorf("expected active item to eq %q, got %q", exp, result)
+		}
+
+		p := Prompt{Label: "Name", Theme: MinimalTheme}
+
+		err = p.prepareTemplates()
+		if err != nil {
+			t.Fatalf("Unexpected error preparing templates %v", err)
+		}
+
+		result = string(render(p.Templates.invalid, "Name"))
+		exp = "\x1b[1m✗\x1b[0m \x1b[1mName\x1b[0m\x1b[1m:\x1b[0m "
+		if result != exp {
+			t.Errorf("expected invalid label to eq %q, got %q", exp, result)
+		}
+
+		result = string(render(p.Templates.validation, "required"))
+		exp = "\x1b[1m>>\x1b[0m \x1b[1mrequired\x1b[0m"
+		if result != exp {
+			t.Errorf("expected validation error to eq %q, got %q", exp, result)
+		}
+	})
+
+	t.Run("prefers the templates of the select over the ones of the theme", func(t *testing.T) {
+		theme := &Theme{
+			Select: SelectTemplates{Active: "> {{ . }}", Inactive: "- {{ . }}"},
+		}
+
+		s := Select{
+			Label:     "Number",
+			Items:     []string{"Zero"},
+			Theme:     theme,
+			Templates: &SelectTemplates{Inactive: "  {{ . | muted }}"},
+		}
+
+		err := s.prepareTemplates()
+		if err != nil {
+			t.Fatalf("Unexpected error preparing templates %v", err)
+		}
+
+		if result := string(render(s.Templates.active, "Zero")); result != "> Zero" {
+			t.Errorf("expected the active template of the theme, got %q", result)
+		}
+
+		exp := "  \x1b[2mZero\x1b[0m"
+		if result := string(render(s.Templates.inactive, "Zero")); result != exp {
+			t.Errorf("expected the inactive template of the select to eq %q, got %q", exp, result)
+		}
+	})
+
+	t.Run("styles the icons with the color profile", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type(promptuitest.Enter)
+
+		s := Select{
+			Label:        "Number",
+			Items:        []string{"Zero"},
+			ColorProfile: NoColor,
+			Stdin:        term.Stdin(),
+			Stdout:       term.Stdout(),
+		}
+
+		_, _, err := s.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if out := term.Output(); !strings.Contains(out, glyphSelect+" Zero") {
+			t.Errorf("expected the active item without styles, got %q", out)
+		}
+
+		s = Select{Label: "Number", Items: []string{"Zero"}}
+		err = s.prepareTemplates()
+		if err != nil {
+			t.Fatalf("Unexpected error preparing templates %v", err)
+		}
+
+		exp := IconSelect + " " + Styler(FGUnderline)("Zero")
+		if result := string(render(s.Templates.active, "Zero")); result != exp {
+			t.Errorf("expected active item to eq %q, got %q", exp, result)
+		}
+	})
+
+	t.Run("keeps the styles and overrides of the Icon variables", func(t *testing.T) {
+		defer func(icon string) { IconGood = icon }(IconGood)
+
+		if icons := resolveTheme(nil).Icons; icons.Good != IconGood || icons.Unchecked != IconUnchecked {
+			t.Errorf("expected the icons of the Icon variables, got %q and %q", icons.Good, icons.Unchecked)
+		}
+
+		IconGood = "ok"
+
+		if icons := resolveTheme(nil).Icons; icons.Good != "ok" {
+			t.Errorf("expected the overridden icon, got %q", icons.Good)
+		}
+	})
+
+	t.Run("applies the default theme", func(t *testing.T) {
+		defer func(theme *Theme) { DefaultTheme = theme }(DefaultTheme)
+		DefaultTheme = &Theme{
+			Icons:  Icons{Initial: "$"},
+			Styles: Styles{Label: Styler(FGUnderline)},
+		}
+
+		p := Prompt{Label: "Name", IsConfirm: true}
+
+		err := p.prepareTemplates()
+		if err != nil {
+			t.Fatalf("Unexpected error preparing templates %v", err)
+		}
+
+		result := string(render(p.Templates.prompt, "Name"))
+		exp := "\x1b[4m$\x1b[0m \x1b[4mName\x1b[0m? \x1b[2m[y/N]\x1b[0m "
+		if result != exp {
+			t.Errorf("expected confirm label to eq %q, got %q", exp, result)
+		}
+	})
+}
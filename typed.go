@@ -0,0 +1,563 @@
+package promptui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultDateLayout is the layout of the dates entered in a DatePrompt without a Layout.
+const DefaultDateLayout = "2006-01-02"
+
+// typed holds what the typed prompts share. It parses the text entered into values of a single type, checks them
+// against the bounds and the validation function of the prompt and steps them with KeyPrev and KeyNext.
+type typed struct {
+	parse    func(input string) (interface{}, error)
+	format   func(v interface{}) string
+	less     func(a, b interface{}) bool
+	add      func(v interface{}, n int) interface{}
+	validate func(v interface{}) error
+
+	// min and max are the bounds of the values, if any, and start is the value stepped from when the input is
+	// empty.
+	min, max interface{}
+	start    interface{}
+}
+
+// check parses input and checks the value against the bounds and the validation function.
+func (t *typed) check(input string) (interface{}, error) {
+	v, err := t.parse(strings.TrimSpace(input))
+	if err != nil {
+		return nil, err
+	}
+
+	if t.min != nil && t.less(v, t.min) {
+		return nil, fmt.Errorf("%s is less than %s", t.format(v), t.format(t.min))
+	}
+
+	if t.max != nil && t.less(t.max, v) {
+		return nil, fmt.Errorf("%s is greater than %s", t.format(v), t.format(t.max))
+	}
+
+	if t.validate != nil {
+		return v, t.validate(v)
+	}
+
+	return v, nil
+}
+
+// step adds n steps to the value entered, keeping it within the bounds.
+func (t *typed) step(input string, n int) (string, bool) {
+	v := t.start
+	if strings.TrimSpace(input) != "" {
+		var err error
+		v, err = t.parse(strings.TrimSpace(input))
+		if err != nil {
+			return "", false
+		}
+	}
+
+	v = t.add(v, n)
+
+	if t.min != nil && t.less(v, t.min) {
+		v = t.min
+	}
+	if t.max != nil && t.less(t.max, v) {
+		v = t.max
+	}
+
+	return t.format(v), true
+}
+
+// run runs p with the live validation and the stepping of t, returning the value entered.
+func (t *typed) run(ctx context.Context, p Prompt) (interface{}, error) {
+	p.Validate = func(input string) error {
+		_, err := t.check(input)
+		return err
+	}
+	p.step = t.step
+
+	input, err := p.RunContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := t.check(input)
+	if isWarning(err) {
+		err = nil
+	}
+	return v, err
+}
+
+// IntPrompt is a prompt for an integer. The value entered is validated as the user types and can be increased
+// or decreased by Step with KeyPrev and KeyNext.
+type IntPrompt struct {
+	// Label is the value displayed on the command line prompt. See the Prompt docs for more info.
+	Label interface{}
+
+	// ID identifies the prompt when looking up preset answers. See the AnswerProvider docs for more info.
+	ID string
+
+	// Default is the initial value for the prompt, if any.
+	Default *int
+
+	// Min and Max are the optional bounds of the value, inclusive.
+	Min *int
+	Max *int
+
+	// Step is the amount by which the value is increased or decreased by the arrow keys. Defaults to 1.
+	Step int
+
+	// Validate is an optional function validating the value once it is parsed and within bounds.
+	Validate func(int) error
+
+	// AllowEdit lets the user edit the default value. See the Prompt docs for more info.
+	AllowEdit bool
+
+	// HideEntered sets whether to hide the text after the user has pressed enter.
+	HideEntered bool
+
+	// Templates can be used to customize the prompt output. See the PromptTemplates docs for more info.
+	Templates *PromptTemplates
+
+	// Theme sets the icons, styles and default templates of the prompt. See the Prompt docs for more info.
+	Theme *Theme
+
+	// ColorProfile limits the styles displayed by the prompt. See the Prompt docs for more info.
+	ColorProfile ColorProfile
+
+	// Accessible runs the prompt in accessible mode. See the Prompt docs for more info.
+	Accessible bool
+
+	// IsVimMode enables vi-like movements (hjkl) and editing.
+	IsVimMode bool
+
+	// Pointer defines how to render the cursor.
+	Pointer Pointer
+
+	Stdin  io.ReadCloser
+	Stdout io.WriteCloser
+}
+
+// Run executes the prompt and returns the integer entered.
+func (p *IntPrompt) Run() (int, error) {
+	return p.RunContext(context.Background())
+}
+
+// RunContext executes the prompt like Run, but stops it as soon as the given context is done.
+func (p *IntPrompt) RunContext(ctx context.Context) (int, error) {
+	step := p.Step
+	if step == 0 {
+		step = 1
+	}
+
+	t := &typed{
+		parse: func(input string) (interface{}, error) {
+			i, err := strconv.Atoi(input)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not an integer", input)
+			}
+			return i, nil
+		},
+		format: func(v interface{}) string { return strconv.Itoa(v.(int)) },
+		less:   func(a, b interface{}) bool { return a.(int) < b.(int) },
+		add:    func(v interface{}, n int) interface{} { return v.(int) + n*step },
+		start:  0,
+	}
+
+	if p.Validate != nil {
+		t.validate = func(v interface{}) error { return p.Validate(v.(int)) }
+	}
+	if p.Min != nil {
+		t.min = *p.Min
+	}
+	if p.Max != nil {
+		t.max = *p.Max
+	}
+
+	prompt := Prompt{
+		Label:        p.Label,
+		ID:           p.ID,
+		AllowEdit:    p.AllowEdit,
+		HideEntered:  p.HideEntered,
+		Templates:    p.Templates,
+		Theme:        p.Theme,
+		ColorProfile: p.ColorProfile,
+		Accessible:   p.Accessible,
+		IsVimMode:    p.IsVimMode,
+		Pointer:      p.Pointer,
+		Stdin:        p.Stdin,
+		Stdout:       p.Stdout,
+	}
+	if p.Default != nil {
+		prompt.Default = strconv.Itoa(*p.Default)
+	}
+
+	v, err := t.run(ctx, prompt)
+	if err != nil {
+		return 0, err
+	}
+
+	return v.(int), nil
+}
+
+// FloatPrompt is a prompt for a floating point number. The value entered is validated as the user types and can
+// be increased or decreased by Step with KeyPrev and KeyNext.
+type FloatPrompt struct {
+	// Label is the value displayed on the command line prompt. See the Prompt docs for more info.
+	Label interface{}
+
+	// ID identifies the prompt when looking up preset answers. See the AnswerProvider docs for more info.
+	ID string
+
+	// Default is the initial value for the prompt, if any.
+	Default *float64
+
+	// Min and Max are the optional bounds of the value, inclusive.
+	Min *float64
+	Max *float64
+
+	// Step is the amount by which the value is increased or decreased by the arrow keys. Defaults to 1.
+	Step float64
+
+	// Validate is an optional function validating the value once it is parsed and within bounds.
+	Validate func(float64) error
+
+	// AllowEdit lets the user edit the default value. See the Prompt docs for more info.
+	AllowEdit bool
+
+	// HideEntered sets whether to hide the text after the user has pressed enter.
+	HideEntered bool
+
+	// Templates can be used to customize the prompt output. See the PromptTemplates docs for more info.
+	Templates *PromptTemplates
+
+	// Theme sets the icons, styles and default templates of the prompt. See the Prompt docs for more info.
+	Theme *Theme
+
+	// ColorProfile limits the styles displayed by the prompt. See the Prompt docs for more info.
+	ColorProfile ColorProfile
+
+	// Accessible runs the prompt in accessible mode. See the Prompt docs for more info.
+	Accessible bool
+
+	// IsVimMode enables vi-like movements (hjkl) and editing.
+	IsVimMode bool
+
+	// Pointer defines how to render the cursor.
+	Pointer Pointer
+
+	Stdin  io.ReadCloser
+	Stdout io.WriteCloser
+}
+
+// Run executes the prompt and returns the number entered.
+func (p *FloatPrompt) Run() (float64, error) {
+	return p.RunContext(context.Background())
+}
+
+// RunContext executes the prompt like Run, but stops it as soon as the given context is done.
+func (p *FloatPrompt) RunContext(ctx context.Context) (float64, error) {
+	step := p.Step
+	if step == 0 {
+		step = 1
+	}
+
+	t := &typed{
+		parse: func(input string) (interface{}, error) {
+			f, err := strconv.ParseFloat(input, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not a number", input)
+			}
+			return f, nil
+		},
+		format: func(v interface{}) string { return strconv.FormatFloat(v.(float64), 'f', -1, 64) },
+		less:   func(a, b interface{}) bool { return a.(float64) < b.(float64) },
+		add: func(v interface{}, n int) interface{} {
+			// the sum is rounded to the decimals of its operands, so that stepping 0.1 from 0.2 gives 0.3 rather
+			// than 0.30000000000000004.
+			d := decimals(v.(float64))
+			if ds := decimals(step); ds > d {
+				d = ds
+			}
+
+			f, _ := strconv.ParseFloat(strconv.FormatFloat(v.(float64)+float64(n)*step, 'f', d, 64), 64)
+			return f
+		},
+		start: 0.0,
+	}
+
+	if p.Validate != nil {
+		t.validate = func(v interface{}) error { return p.Validate(v.(float64)) }
+	}
+	if p.Min != nil {
+		t.min = *p.Min
+	}
+	if p.Max != nil {
+		t.max = *p.Max
+	}
+
+	prompt := Prompt{
+		Label:        p.Label,
+		ID:           p.ID,
+		AllowEdit:    p.AllowEdit,
+		HideEntered:  p.HideEntered,
+		Templates:    p.Templates,
+		Theme:        p.Theme,
+		ColorProfile: p.ColorProfile,
+		Accessible:   p.Accessible,
+		IsVimMode:    p.IsVimMode,
+		Pointer:      p.Pointer,
+		Stdin:        p.Stdin,
+		Stdout:       p.Stdout,
+	}
+	if p.Default != nil {
+		prompt.Default = strconv.FormatFloat(*p.Default, 'f', -1, 64)
+	}
+
+	v, err := t.run(ctx, prompt)
+	if err != nil {
+		return 0, err
+	}
+
+	return v.(float64), nil
+}
+
+// decimals returns the number of decimals of f.
+func decimals(f float64) int {
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if i := strings.Index(s, "."); i >= 0 {
+		return len(s) - i - 1
+	}
+	return 0
+}
+
+// DurationPrompt is a prompt for a duration, entered in the format of time.ParseDuration such as "1h30m". The
+// value entered is validated as the user types and can be increased or decreased by Step with KeyPrev and
+// KeyNext.
+type DurationPrompt struct {
+	// Label is the value displayed on the command line prompt. See the Prompt docs for more info.
+	Label interface{}
+
+	// ID identifies the prompt when looking up preset answers. See the AnswerProvider docs for more info.
+	ID string
+
+	// Default is the initial value for the prompt, if any.
+	Default *time.Duration
+
+	// Min and Max are the optional bounds of the value, inclusive.
+	Min *time.Duration
+	Max *time.Duration
+
+	// Step is the amount by which the value is increased or decreased by the arrow keys. Defaults to a second.
+	Step time.Duration
+
+	// Validate is an optional function validating the value once it is parsed and within bounds.
+	Validate func(time.Duration) error
+
+	// AllowEdit lets the user edit the default value. See the Prompt docs for more info.
+	AllowEdit bool
+
+	// HideEntered sets whether to hide the text after the user has pressed enter.
+	HideEntered bool
+
+	// Templates can be used to customize the prompt output. See the PromptTemplates docs for more info.
+	Templates *PromptTemplates
+
+	// Theme sets the icons, styles and default templates of the prompt. See the Prompt docs for more info.
+	Theme *Theme
+
+	// ColorProfile limits the styles displayed by the prompt. See the Prompt docs for more info.
+	ColorProfile ColorProfile
+
+	// Accessible runs the prompt in accessible mode. See the Prompt docs for more info.
+	Accessible bool
+
+	// IsVimMode enables vi-like movements (hjkl) and editing.
+	IsVimMode bool
+
+	// Pointer defines how to render the cursor.
+	Pointer Pointer
+
+	Stdin  io.ReadCloser
+	Stdout io.WriteCloser
+}
+
+// Run executes the prompt and returns the duration entered.
+func (p *DurationPrompt) Run() (time.Duration, error) {
+	return p.RunContext(context.Background())
+}
+
+// RunContext executes the prompt like Run, but stops it as soon as the given context is done.
+func (p *DurationPrompt) RunContext(ctx context.Context) (time.Duration, error) {
+	step := p.Step
+	if step == 0 {
+		step = time.Second
+	}
+
+	t := &typed{
+		parse: func(input string) (interface{}, error) {
+			d, err := time.ParseDuration(input)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not a duration", input)
+			}
+			return d, nil
+		},
+		format: func(v interface{}) string { return v.(time.Duration).String() },
+		less:   func(a, b interface{}) bool { return a.(time.Duration) < b.(time.Duration) },
+		add:    func(v interface{}, n int) interface{} { return v.(time.Duration) + time.Duration(n)*step },
+		start:  time.Duration(0),
+	}
+
+	if p.Validate != nil {
+		t.validate = func(v interface{}) error { return p.Validate(v.(time.Duration)) }
+	}
+	if p.Min != nil {
+		t.min = *p.Min
+	}
+	if p.Max != nil {
+		t.max = *p.Max
+	}
+
+	prompt := Prompt{
+		Label:        p.Label,
+		ID:           p.ID,
+		AllowEdit:    p.AllowEdit,
+		HideEntered:  p.HideEntered,
+		Templates:    p.Templates,
+		Theme:        p.Theme,
+		ColorProfile: p.ColorProfile,
+		Accessible:   p.Accessible,
+		IsVimMode:    p.IsVimMode,
+		Pointer:      p.Pointer,
+		Stdin:        p.Stdin,
+		Stdout:       p.Stdout,
+	}
+	if p.Default != nil {
+		prompt.Default = p.Default.String()
+	}
+
+	v, err := t.run(ctx, prompt)
+	if err != nil {
+		return 0, err
+	}
+
+	return v.(time.Duration), nil
+}
+
+// DatePrompt is a prompt for a date, entered in the local time zone with the given Layout. The value entered is
+// validated as the user types and can be moved a day forward or backward with KeyPrev and KeyNext.
+type DatePrompt struct {
+	// Label is the value displayed on the command line prompt. See the Prompt docs for more info.
+	Label interface{}
+
+	// ID identifies the prompt when looking up preset answers. See the AnswerProvider docs for more info.
+	ID string
+
+	// Layout is the layout of the date as defined by time.Parse. Defaults to DefaultDateLayout.
+	Layout string
+
+	// Default is the initial value for the prompt, if any.
+	Default *time.Time
+
+	// Min and Max are the optional bounds of the date, inclusive.
+	Min *time.Time
+	Max *time.Time
+
+	// Validate is an optional function validating the date once it is parsed and within bounds.
+	Validate func(time.Time) error
+
+	// AllowEdit lets the user edit the default value. See the Prompt docs for more info.
+	AllowEdit bool
+
+	// HideEntered sets whether to hide the text after the user has pressed enter.
+	HideEntered bool
+
+	// Templates can be used to customize the prompt output. See the PromptTemplates docs for more info.
+	Templates *PromptTemplates
+
+	// Theme sets the icons, styles and default templates of the prompt. See the Prompt docs for more info.
+	Theme *Theme
+
+	// ColorProfile limits the styles displayed by the prompt. See the Prompt docs for more info.
+	ColorProfile ColorProfile
+
+	// Accessible runs the prompt in accessible mode. See the Prompt docs for more info.
+	Accessible bool
+
+	// IsVimMode enables vi-like movements (hjkl) and editing.
+	IsVimMode bool
+
+	// Pointer defines how to render the cursor.
+	Pointer Pointer
+
+	Stdin  io.ReadCloser
+	Stdout io.WriteCloser
+}
+
+// Run executes the prompt and returns the date entered.
+func (p *DatePrompt) Run() (time.Time, error) {
+	return p.RunContext(context.Background())
+}
+
+// RunContext executes the prompt like Run, but stops it as soon as the given context is done.
+func (p *DatePrompt) RunContext(ctx context.Context) (time.Time, error) {
+	layout := p.Layout
+	if layout == "" {
+		layout = DefaultDateLayout
+	}
+
+	now := time.Now()
+
+	t := &typed{
+		parse: func(input string) (interface{}, error) {
+			d, err := time.ParseInLocation(layout, input, time.Local)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not a date like %s", input, layout)
+			}
+			return d, nil
+		},
+		format: func(v interface{}) string { return v.(time.Time).Format(layout) },
+		less:   func(a, b interface{}) bool { return a.(time.Time).Before(b.(time.Time)) },
+		add:    func(v interface{}, n int) interface{} { return v.(time.Time).AddDate(0, 0, n) },
+		start:  time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local),
+	}
+
+	if p.Validate != nil {
+		t.validate = func(v interface{}) error { return p.Validate(v.(time.Time)) }
+	}
+	if p.Min != nil {
+		t.min = *p.Min
+	}
+	if p.Max != nil {
+		t.max = *p.Max
+	}
+
+	prompt := Prompt{
+		Label:        p.Label,
+		ID:           p.ID,
+		AllowEdit:    p.AllowEdit,
+		HideEntered:  p.HideEntered,
+		Templates:    p.Templates,
+		Theme:        p.Theme,
+		ColorProfile: p.ColorProfile,
+		Accessible:   p.Accessible,
+		IsVimMode:    p.IsVimMode,
+		Pointer:      p.Pointer,
+		Stdin:        p.Stdin,
+		Stdout:       p.Stdout,
+	}
+	if p.Default != nil {
+		prompt.Default = p.Default.Format(layout)
+	}
+
+	v, err := t.run(ctx, prompt)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return v.(time.Time), nil
+}
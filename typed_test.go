@@ -0,0 +1,202 @@
+package promptui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/manifoldco/promptui/promptuitest"
+)
+
+func TestIntPrompt(t *testing.T) {
+	t.Run("steps the value within bounds", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type(promptuitest.Up, promptuitest.Up, promptuitest.Up, promptuitest.Down, promptuitest.Enter)
+
+		def, max := 8, 10
+		p := IntPrompt{
+			Label:   "Replicas",
+			Default: &def,
+			Max:     &max,
+			Step:    2,
+			Stdin:   term.Stdin(),
+			Stdout:  term.Stdout(),
+		}
+
+		result, err := p.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if result != 8 {
+			t.Errorf("expected %d, got %d", 8, result)
+		}
+	})
+
+	t.Run("rejects invalid values", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type("x", promptuitest.Enter, promptuitest.Backspace, "0", promptuitest.Enter, promptuitest.Backspace,
+			"3", promptuitest.Enter)
+
+		min := 1
+		p := IntPrompt{
+			Label:  "Replicas",
+			Min:    &min,
+			Stdin:  term.Stdin(),
+			Stdout: term.Stdout(),
+		}
+
+		result, err := p.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if result != 3 {
+			t.Errorf("expected %d, got %d", 3, result)
+		}
+
+		output := term.Output()
+		for _, exp := range []string{`"x" is not an integer`, "0 is less than 1"} {
+			if !strings.Contains(output, exp) {
+				t.Errorf("expected output to contain %q, got %q", exp, output)
+			}
+		}
+	})
+
+	t.Run("accepts values with a warning", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type("12", promptuitest.Enter)
+
+		p := IntPrompt{
+			Label: "Replicas",
+			Validate: func(i int) error {
+				if i > 10 {
+					return Warnf("%d replicas may exceed the quota", i)
+				}
+				return nil
+			},
+			Stdin:  term.Stdin(),
+			Stdout: term.Stdout(),
+		}
+
+		result, err := p.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if result != 12 {
+			t.Errorf("expected %d, got %d", 12, result)
+		}
+	})
+
+	t.Run("uses its theme and color profile", func(t *testing.T) {
+		term := promptuitest.New()
+		term.Type("3", promptuitest.Enter)
+
+		p := IntPrompt{
+			Label:        "Replicas",
+			Theme:        &Theme{Icons: Icons{Good: "ok"}},
+			ColorProfile: NoColor,
+			Stdin:        term.Stdin(),
+			Stdout:       term.Stdout(),
+		}
+
+		_, err := p.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if out := term.Output(); !strings.Contains(out, "ok Replicas: 3") || strings.Contains(out, "\x1b[0m") {
+			t.Errorf("expected the unstyled icon of the theme, got %q", out)
+		}
+	})
+
+	t.Run("runs in accessible mode", func(t *testing.T) {
+		stdin := pipeInput(t, "x\n3\n")
+		defer stdin.Close()
+
+		out := &nopWriteCloser{}
+		p := IntPrompt{Label: "Replicas", Accessible: true, Stdin: stdin, Stdout: out}
+
+		result, err := p.Run()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if result != 3 {
+			t.Errorf("expected %d, got %d", 3, result)
+		}
+
+		exp := "Replicas: \nError: \"x\" is not an integer\nReplicas: \n"
+		if got := out.String(); got != exp {
+			t.Errorf("expected output %q, got %q", exp, got)
+		}
+	})
+}
+
+func TestFloatPrompt(t *testing.T) {
+	term := promptuitest.New()
+	term.Type("1.25", promptuitest.Up, promptuitest.Up, promptuitest.Enter)
+
+	p := FloatPrompt{
+		Label:  "Ratio",
+		Step:   0.1,
+		Stdin:  term.Stdin(),
+		Stdout: term.Stdout(),
+	}
+
+	result, err := p.Run()
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if result != 1.45 {
+		t.Errorf("expected %v, got %v", 1.45, result)
+	}
+}
+
+func TestDurationPrompt(t *testing.T) {
+	term := promptuitest.New()
+	term.Type(promptuitest.Down, promptuitest.Enter)
+
+	def := time.Minute
+	p := DurationPrompt{
+		Label:   "Timeout",
+		Default: &def,
+		Step:    10 * time.Second,
+		Stdin:   term.Stdin(),
+		Stdout:  term.Stdout(),
+	}
+
+	result, err := p.Run()
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if result != 50*time.Second {
+		t.Errorf("expected %v, got %v", 50*time.Second, result)
+	}
+}
+
+func TestDatePrompt(t *testing.T) {
+	term := promptuitest.New()
+	term.Type("2024-02-28", promptuitest.Up, promptuitest.Up, promptuitest.Enter)
+
+	max := time.Date(2024, 3, 31, 0, 0, 0, 0, time.Local)
+	p := DatePrompt{
+		Label:  "Release",
+		Max:    &max,
+		Stdin:  term.Stdin(),
+		Stdout: term.Stdout(),
+	}
+
+	result, err := p.Run()
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	exp := time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)
+	if !result.Equal(exp) {
+		t.Errorf("expected %v, got %v", exp, result)
+	}
+}
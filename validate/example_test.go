@@ -0,0 +1,29 @@
+package validate_test
+
+import (
+	"fmt"
+
+	"github.com/manifoldco/promptui"
+	"github.com/manifoldco/promptui/validate"
+)
+
+// This example shows a prompt for a host which must be given, either as a host name or an IP address, with a
+// custom message for the required rule.
+func Example() {
+	prompt := promptui.Prompt{
+		Label:    "Host",
+		Validate: validate.All(validate.Required(), validate.MaxLength(64), validate.Hostname()),
+		Templates: &promptui.PromptTemplates{
+			ValidationError: `{{ if eq .Rule "required" }}{{ "Please enter a host" | red }}{{ else }}{{ . | red }}{{ end }}`,
+		},
+	}
+
+	result, err := prompt.Run()
+
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return
+	}
+
+	fmt.Printf("Connecting to %s\n", result)
+}
@@ -0,0 +1,103 @@
+package validate
+
+import (
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"strings"
+)
+
+// Email returns a validator failing with the rule "email" when the input is not an email address such as
+// "gopher@example.com". Addresses with a display name are rejected.
+func Email() func(string) error {
+	return func(input string) error {
+		if input == "" {
+			return nil
+		}
+
+		addr, err := mail.ParseAddress(input)
+		if err == nil && addr.Address == input && strings.Contains(input[strings.LastIndex(input, "@"):], ".") {
+			return nil
+		}
+
+		return &Error{Rule: "email", Input: input, Message: fmt.Sprintf("%s is not an email address", input)}
+	}
+}
+
+// URL returns a validator failing with the rule "url" when the input is not an absolute URL. When schemes are
+// given, the URL must use one of them and they are set as the "schemes" parameter.
+func URL(schemes ...string) func(string) error {
+	return func(input string) error {
+		if input == "" {
+			return nil
+		}
+
+		u, err := url.Parse(input)
+		if err == nil && u.Scheme != "" && u.Host != "" {
+			if len(schemes) == 0 {
+				return nil
+			}
+
+			for _, s := range schemes {
+				if strings.EqualFold(u.Scheme, s) {
+					return nil
+				}
+			}
+
+			return &Error{
+				Rule:    "url",
+				Input:   input,
+				Params:  map[string]interface{}{"schemes": schemes},
+				Message: fmt.Sprintf("must be a %s URL", strings.Join(schemes, " or ")),
+			}
+		}
+
+		return &Error{Rule: "url", Input: input, Message: fmt.Sprintf("%s is not a URL", input)}
+	}
+}
+
+// Hostname returns a validator failing with the rule "hostname" when the input is neither a host name as defined
+// by RFC 1123, such as "db1.example.com", nor an IP address.
+func Hostname() func(string) error {
+	return func(input string) error {
+		if input == "" || net.ParseIP(input) != nil || isHostname(input) {
+			return nil
+		}
+
+		return &Error{Rule: "hostname", Input: input, Message: fmt.Sprintf("%s is not a host name", input)}
+	}
+}
+
+// IP returns a validator failing with the rule "ip" when the input is not an IPv4 or IPv6 address.
+func IP() func(string) error {
+	return func(input string) error {
+		if input == "" || net.ParseIP(input) != nil {
+			return nil
+		}
+
+		return &Error{Rule: "ip", Input: input, Message: fmt.Sprintf("%s is not an IP address", input)}
+	}
+}
+
+// isHostname returns whether s is a host name made of labels of letters, digits and hyphens separated by dots.
+func isHostname(s string) bool {
+	s = strings.TrimSuffix(s, ".")
+	if len(s) == 0 || len(s) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(s, ".") {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+
+	return true
+}
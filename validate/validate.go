@@ -0,0 +1,214 @@
+// Package validate provides validation functions for the input of prompts. Each constructor returns a function
+// which can be used as the Validate function of a promptui.Prompt, and which can be combined with All and Any:
+//
+//	prompt := promptui.Prompt{
+//		Label:    "Email",
+//		Validate: validate.All(validate.Required(), validate.Email()),
+//	}
+//
+// Except for Required, the validators accept an empty input so that optional answers can be validated too.
+// Combine them with Required when an answer is mandatory.
+//
+// The errors returned are of type *Error, which holds the rule that failed along with its parameters. They can
+// be used by the ValidationError template of a prompt to display a custom message, for example:
+//
+//	{{ if eq .Rule "min_length" }}Please enter at least {{ .Params.min }} characters{{ else }}{{ . }}{{ end }}
+package validate
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Error is the error returned by the validators of this package.
+type Error struct {
+	// Rule is the name of the rule the input failed, such as "required" or "min_length".
+	Rule string
+
+	// Input is the input that failed the rule.
+	Input string
+
+	// Params holds the parameters of the rule, such as the minimum length for "min_length". See the docs of each
+	// validator for its parameters.
+	Params map[string]interface{}
+
+	// Message is a description of the error, returned by Error.
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Required returns a validator failing with the rule "required" when the input is empty or only made of spaces.
+func Required() func(string) error {
+	return func(input string) error {
+		if strings.TrimSpace(input) == "" {
+			return &Error{Rule: "required", Input: input, Message: "value is required"}
+		}
+		return nil
+	}
+}
+
+// MinLength returns a validator failing with the rule "min_length" when the input has fewer than min characters.
+// The minimum is set as the "min" parameter.
+func MinLength(min int) func(string) error {
+	return func(input string) error {
+		if input == "" || utf8.RuneCountInString(input) >= min {
+			return nil
+		}
+
+		return &Error{
+			Rule:    "min_length",
+			Input:   input,
+			Params:  map[string]interface{}{"min": min},
+			Message: fmt.Sprintf("must be at least %d characters long", min),
+		}
+	}
+}
+
+// MaxLength returns a validator failing with the rule "max_length" when the input has more than max characters.
+// The maximum is set as the "max" parameter.
+func MaxLength(max int) func(string) error {
+	return func(input string) error {
+		if utf8.RuneCountInString(input) <= max {
+			return nil
+		}
+
+		return &Error{
+			Rule:    "max_length",
+			Input:   input,
+			Params:  map[string]interface{}{"max": max},
+			Message: fmt.Sprintf("must be at most %d characters long", max),
+		}
+	}
+}
+
+// Regexp returns a validator failing with the rule "regexp" when the input does not match re. The expression
+// is set as the "regexp" parameter.
+func Regexp(re *regexp.Regexp) func(string) error {
+	return func(input string) error {
+		if input == "" || re.MatchString(input) {
+			return nil
+		}
+
+		return &Error{
+			Rule:    "regexp",
+			Input:   input,
+			Params:  map[string]interface{}{"regexp": re.String()},
+			Message: fmt.Sprintf("must match %s", re),
+		}
+	}
+}
+
+// IntRange returns a validator failing with the rule "int_range" when the input is not an integer between min and
+// max, inclusive. The bounds are set as the "min" and "max" parameters.
+func IntRange(min, max int) func(string) error {
+	return func(input string) error {
+		if input == "" {
+			return nil
+		}
+
+		i, err := strconv.Atoi(input)
+		if err == nil && i >= min && i <= max {
+			return nil
+		}
+
+		return &Error{
+			Rule:    "int_range",
+			Input:   input,
+			Params:  map[string]interface{}{"min": min, "max": max},
+			Message: fmt.Sprintf("must be a number between %d and %d", min, max),
+		}
+	}
+}
+
+// OneOf returns a validator failing with the rule "one_of" when the input is not one of values. The values are set
+// as the "values" parameter.
+func OneOf(values ...string) func(string) error {
+	return func(input string) error {
+		if input == "" {
+			return nil
+		}
+
+		for _, v := range values {
+			if input == v {
+				return nil
+			}
+		}
+
+		return &Error{
+			Rule:    "one_of",
+			Input:   input,
+			Params:  map[string]interface{}{"values": values},
+			Message: fmt.Sprintf("must be one of %s", strings.Join(values, ", ")),
+		}
+	}
+}
+
+// PathExists returns a validator failing with the rule "path_exists" when the input is not the path of an
+// existing file or directory.
+func PathExists() func(string) error {
+	return func(input string) error {
+		if input == "" {
+			return nil
+		}
+
+		_, err := os.Stat(input)
+		if err == nil {
+			return nil
+		}
+
+		return &Error{
+			Rule:    "path_exists",
+			Input:   input,
+			Message: fmt.Sprintf("%s does not exist", input),
+		}
+	}
+}
+
+// All returns a validator failing with the first error returned by validators, if any.
+func All(validators ...func(string) error) func(string) error {
+	return func(input string) error {
+		for _, v := range validators {
+			if err := v(input); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// Any returns a validator succeeding when any of validators does. Otherwise, it fails with the rule "any", the
+// errors of the validators being set as the "errors" parameter.
+func Any(validators ...func(string) error) func(string) error {
+	return func(input string) error {
+		var errs []error
+		var msgs []string
+
+		for _, v := range validators {
+			err := v(input)
+			if err == nil {
+				return nil
+			}
+
+			errs = append(errs, err)
+			msgs = append(msgs, err.Error())
+		}
+
+		if len(errs) == 0 {
+			return nil
+		}
+
+		return &Error{
+			Rule:    "any",
+			Input:   input,
+			Params:  map[string]interface{}{"errors": errs},
+			Message: strings.Join(msgs, " or "),
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package validate
+
+import (
+	"io/ioutil"
+	"os"
+	"regexp"
+	"testing"
+)
+
+func TestValidators(t *testing.T) {
+	file, err := ioutil.TempFile("", "validate")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	file.Close()
+	defer os.Remove(file.Name())
+
+	tcs := []struct {
+		name     string
+		validate func(string) error
+		valid    []string
+		invalid  []string
+		rule     string
+	}{
+		{"required", Required(), []string{"a"}, []string{"", "  "}, "required"},
+		{"min length", MinLength(3), []string{"", "abc", "äöü"}, []string{"ab"}, "min_length"},
+		{"max length", MaxLength(3), []string{"", "abc", "äöü"}, []string{"abcd"}, "max_length"},
+		{"regexp", Regexp(regexp.MustCompile(`^[a-z]+$`)), []string{"", "abc"}, []string{"ABC", "a1"}, "regexp"},
+		{"int range", IntRange(1, 10), []string{"", "1", "10"}, []string{"0", "11", "a"}, "int_range"},
+		{"one of", OneOf("dev", "prod"), []string{"", "dev"}, []string{"Dev", "staging"}, "one_of"},
+		{"path exists", PathExists(), []string{"", file.Name()}, []string{file.Name() + ".missing"}, "path_exists"},
+		{
+			"email", Email(),
+			[]string{"", "gopher@example.com"},
+			[]string{"gopher", "Gopher <gopher@example.com>", "gopher@example"},
+			"email",
+		},
+		{
+			"url", URL(),
+			[]string{"", "https://example.com/path", "ftp://example.com"},
+			[]string{"example.com", "/path", "https://"},
+			"url",
+		},
+		{"url schemes", URL("http", "https"), []string{"https://example.com"}, []string{"ftp://example.com"}, "url"},
+		{
+			"hostname", Hostname(),
+			[]string{"", "localhost", "db-1.example.com", "10.0.0.1", "::1"},
+			[]string{"-db.example.com", "db_1.example.com", "db..example.com"},
+			"hostname",
+		},
+		{"ip", IP(), []string{"", "10.0.0.1", "::1"}, []string{"localhost", "10.0.0.256"}, "ip"},
+		{
+			"all", All(Required(), MaxLength(3)),
+			[]string{"abc"},
+			[]string{"", "abcd"},
+			"",
+		},
+		{
+			"any", Any(IP(), OneOf("localhost")),
+			[]string{"10.0.0.1", "localhost"},
+			[]string{"example.com"},
+			"any",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, input := range tc.valid {
+				if err := tc.validate(input); err != nil {
+					t.Errorf("expected %q to be valid, got %v", input, err)
+				}
+			}
+
+			for _, input := range tc.invalid {
+				err := tc.validate(input)
+				if err == nil {
+					t.Errorf("expected %q to be invalid", input)
+					continue
+				}
+
+				verr, ok := err.(*Error)
+				if !ok {
+					t.Errorf("expected an *Error for %q, got %T", input, err)
+					continue
+				}
+
+				if tc.rule != "" && verr.Rule != tc.rule {
+					t.Errorf("expected rule %q for %q, got %q", tc.rule, input, verr.Rule)
+				}
+
+				if verr.Input != input {
+					t.Errorf("expected input %q, got %q", input, verr.Input)
+				}
+			}
+		})
+	}
+}
+
+func TestErrorParams(t *testing.T) {
+	err := IntRange(1, 10)("42").(*Error)
+
+	if err.Params["min"] != 1 || err.Params["max"] != 10 {
+		t.Errorf("expected the bounds as params, got %v", err.Params)
+	}
+
+	if err.Error() != "must be a number between 1 and 10" {
+		t.Errorf("unexpected message %q", err.Error())
+	}
+}
@@ -0,0 +1,48 @@
+package promptui
+
+import (
+	"io"
+	"os"
+
+	"github.com/chzyer/readline"
+)
+
+// Overflow sets how selects display the items wider than the terminal.
+type Overflow int
+
+const (
+	// OverflowWrap wraps the items wider than the terminal on several lines, as the terminal does.
+	OverflowWrap Overflow = iota
+
+	// OverflowTruncate cuts the items wider than the terminal, ending them with an ellipsis.
+	OverflowTruncate
+)
+
+// widther is implemented by outputs which know the number of columns of the terminal they write to, such as the
+// Stdout of promptuitest terminals.
+type widther interface {
+	Width() int
+}
+
+// terminalWidth returns the number of columns of the terminal out writes to, defaulting to the standard output.
+// It returns 0 when the width is unknown, such as when out is not a terminal.
+func terminalWidth(out io.Writer) int {
+	if w, ok := out.(widther); ok {
+		return w.Width()
+	}
+
+	if out == nil {
+		out = os.Stdout
+	}
+
+	f, ok := out.(fileDescriptor)
+	if !ok {
+		return 0
+	}
+
+	width, _, err := readline.GetSize(int(f.Fd()))
+	if err != nil || width < 0 {
+		return 0
+	}
+	return width
+}